@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetActiveDirectoryByName(ctx context.Context, name string) (*fb.ActiveDirectory, error) {
+	params := &fb.GetApi217ActiveDirectoryParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ActiveDirectoryWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Active Directory account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetActiveDirectory", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateActiveDirectory(ctx context.Context, name string, joinExistingAccount bool, body *fb.ActiveDirectoryPost) (*fb.ActiveDirectory, error) {
+	params := &fb.PostApi217ActiveDirectoryParams{Names: &[]string{name}, JoinExistingAccount: &joinExistingAccount}
+	resp, err := c.PostApi217ActiveDirectoryWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Active Directory account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateActiveDirectory", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created Active Directory account in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateActiveDirectory(ctx context.Context, name string, body *fb.ActiveDirectoryPatch) (*fb.ActiveDirectory, error) {
+	params := &fb.PatchApi217ActiveDirectoryParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217ActiveDirectoryWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update Active Directory account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateActiveDirectory", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated Active Directory account in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteActiveDirectory(ctx context.Context, name string, localOnly bool) error {
+	params := &fb.DeleteApi217ActiveDirectoryParams{Names: &[]string{name}, LocalOnly: &localOnly}
+	resp, err := c.DeleteApi217ActiveDirectoryWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete Active Directory account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteActiveDirectory", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// TestActiveDirectory checks that the array can reach the domain controllers and that its computer
+// account is healthy.
+func (c *Client) TestActiveDirectory(ctx context.Context, name string) ([]fb.TestResult, error) {
+	resp, err := c.GetApi217ActiveDirectoryTestWithResponse(ctx, &fb.GetApi217ActiveDirectoryTestParams{Names: &[]string{name}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test Active Directory account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestActiveDirectory", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetAdminByName(ctx context.Context, name string) (*fb.Admin, error) {
+	params := &fb.GetApi217AdminsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217AdminsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetAdmin", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateAdmin(ctx context.Context, name string, body *fb.AdminPost) (*fb.Admin, error) {
+	params := &fb.PostApi217AdminsParams{Names: &[]string{name}}
+	resp, err := c.PostApi217AdminsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateAdmin", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created admin in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateAdmin(ctx context.Context, name string, body *fb.AdminPatch) (*fb.Admin, error) {
+	params := &fb.PatchApi217AdminsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217AdminsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update admin: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateAdmin", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated admin in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteAdmin(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217AdminsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217AdminsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete admin: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteAdmin", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// GetAdminApiToken returns the API token of an admin without exposing the token itself, or nil if
+// the admin has none.
+func (c *Client) GetAdminApiToken(ctx context.Context, adminName string) (*fb.AdminApiToken, error) {
+	params := &fb.GetApi217AdminsApiTokensParams{AdminNames: &[]string{adminName}}
+	resp, err := c.GetApi217AdminsApiTokensWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin API token: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetAdminApiToken", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	token := (*resp.JSON200.Items)[0]
+	if token.ApiToken == nil {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// CreateAdminApiToken issues a new API token for an admin, replacing any existing one. timeout is
+// the validity of the token in milliseconds; nil means the token doesn't expire.
+func (c *Client) CreateAdminApiToken(ctx context.Context, adminName string, timeout *int64) (*fb.AdminApiToken, error) {
+	params := &fb.PostApi217AdminsApiTokensParams{AdminNames: &[]string{adminName}, Timeout: timeout}
+	resp, err := c.PostApi217AdminsApiTokensWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin API token: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateAdminApiToken", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created admin API token in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteAdminApiToken(ctx context.Context, adminName string) error {
+	params := &fb.DeleteApi217AdminsApiTokensParams{AdminNames: &[]string{adminName}}
+	resp, err := c.DeleteApi217AdminsApiTokensWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete admin API token: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteAdminApiToken", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetAlertWatcherByName(ctx context.Context, name string) (*fb.AlertWatcher, error) {
+	params := &fb.GetApi217AlertWatchersParams{Names: &[]string{name}}
+	resp, err := c.GetApi217AlertWatchersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert watcher: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetAlertWatcher", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateAlertWatcher(ctx context.Context, name string, body *fb.AlertWatcherPost) (*fb.AlertWatcher, error) {
+	params := &fb.PostApi217AlertWatchersParams{Names: []string{name}}
+	resp, err := c.PostApi217AlertWatchersWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alert watcher: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateAlertWatcher", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created alert watcher in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateAlertWatcher(ctx context.Context, name string, body *fb.AlertWatcher) (*fb.AlertWatcher, error) {
+	params := &fb.PatchApi217AlertWatchersParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217AlertWatchersWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update alert watcher: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateAlertWatcher", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated alert watcher in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteAlertWatcher(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217AlertWatchersParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217AlertWatchersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert watcher: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteAlertWatcher", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// TestAlertWatchers sends a test alert to the given watchers, or to all of them if no names are
+// given. This also exercises the SMTP relay of the array.
+func (c *Client) TestAlertWatchers(ctx context.Context, names ...string) ([]fb.TestResult, error) {
+	params := &fb.GetApi217AlertWatchersTestParams{}
+	if len(names) > 0 {
+		params.Names = &names
+	}
+	resp, err := c.GetApi217AlertWatchersTestWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to test alert watchers: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestAlertWatchers", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// GetArray returns the settings of the array the client is connected to.
+func (c *Client) GetArray(ctx context.Context) (*fb.Array, error) {
+	resp, err := c.GetApi217ArraysWithResponse(ctx, &fb.GetApi217ArraysParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get array: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetArray", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return the array in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateArray(ctx context.Context, body *fb.Array) (*fb.Array, error) {
+	resp, err := c.PatchApi217ArraysWithResponse(ctx, &fb.PatchApi217ArraysParams{}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update array: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateArray", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated array in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) GetArrayEula(ctx context.Context) (*fb.Eula, error) {
+	resp, err := c.GetApi217ArraysEulaWithResponse(ctx, &fb.GetApi217ArraysEulaParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get array EULA: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetArrayEula", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// AcceptArrayEula signs the end user license agreement on behalf of the given signatory.
+func (c *Client) AcceptArrayEula(ctx context.Context, signature *fb.EulaSignature) (*fb.Eula, error) {
+	resp, err := c.PatchApi217ArraysEulaWithResponse(ctx, &fb.PatchApi217ArraysEulaParams{}, fb.Eula{Signature: signature})
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept array EULA: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("AcceptArrayEula", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return accepted EULA in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// ListSupportedTimeZones returns the names of the time zones the array accepts, following
+// continuation tokens.
+func (c *Client) ListSupportedTimeZones(ctx context.Context) ([]string, error) {
+	params := &fb.GetApi217ArraysSupportedTimeZonesParams{}
+	var names []string
+	for {
+		resp, err := c.GetApi217ArraysSupportedTimeZonesWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list supported time zones: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListSupportedTimeZones", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return names, nil
+		}
+		if resp.JSON200.Items != nil {
+			for _, tz := range *resp.JSON200.Items {
+				if tz.Name != nil {
+					names = append(names, *tz.Name)
+				}
+			}
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return names, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// CreateArrayConnectionKey generates a new connection key on this array. The key is handed to
+// the array that initiates the connection and expires shortly after it is created.
+func (c *Client) CreateArrayConnectionKey(ctx context.Context) (*fb.ArrayConnectionKey, error) {
+	resp, err := c.PostApi217ArrayConnectionsConnectionKeyWithResponse(ctx, &fb.PostApi217ArrayConnectionsConnectionKeyParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create array connection key: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateArrayConnectionKey", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created array connection key in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// GetArrayConnectionByID looks up a connection by ID, because the remote array's name is only
+// learned once the connection has been established.
+func (c *Client) GetArrayConnectionByID(ctx context.Context, id string) (*fb.ArrayConnection, error) {
+	params := &fb.GetApi217ArrayConnectionsParams{Ids: &[]string{id}}
+	resp, err := c.GetApi217ArrayConnectionsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get array connection: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetArrayConnection", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) GetArrayConnectionByRemoteName(ctx context.Context, remoteName string) (*fb.ArrayConnection, error) {
+	params := &fb.GetApi217ArrayConnectionsParams{RemoteNames: &[]string{remoteName}}
+	resp, err := c.GetApi217ArrayConnectionsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get array connection: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetArrayConnection", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateArrayConnection(ctx context.Context, body *fb.ArrayConnectionPost) (*fb.ArrayConnection, error) {
+	resp, err := c.PostApi217ArrayConnectionsWithResponse(ctx, &fb.PostApi217ArrayConnectionsParams{}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create array connection: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateArrayConnection", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created array connection in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateArrayConnection(ctx context.Context, id string, body *fb.ArrayConnection) (*fb.ArrayConnection, error) {
+	params := &fb.PatchApi217ArrayConnectionsParams{Ids: &[]string{id}}
+	resp, err := c.PatchApi217ArrayConnectionsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update array connection: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateArrayConnection", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated array connection in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteArrayConnection(ctx context.Context, id string) error {
+	params := &fb.DeleteApi217ArrayConnectionsParams{Ids: &[]string{id}}
+	resp, err := c.DeleteApi217ArrayConnectionsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete array connection: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteArrayConnection", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetBucketByName(ctx context.Context, name string) (*fb.Bucket, error) {
+	params := &fb.GetApi217BucketsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217BucketsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetBucket", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateBucket(ctx context.Context, name string, bucket *fb.BucketPost) (*fb.Bucket, error) {
+	params := &fb.PostApi217BucketsParams{Names: []string{name}}
+	resp, err := c.PostApi217BucketsWithResponse(ctx, params, *bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateBucket", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created bucket in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateBucket(ctx context.Context, name string, bucket *fb.BucketPatch) (*fb.Bucket, error) {
+	params := &fb.PatchApi217BucketsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217BucketsWithResponse(ctx, params, *bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bucket: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateBucket", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated bucket in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) EradicateBucket(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217BucketsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217BucketsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to eradicate bucket: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("EradicateBucket", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
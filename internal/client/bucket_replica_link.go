@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetBucketReplicaLinkByID(ctx context.Context, id string) (*fb.BucketReplicaLink, error) {
+	params := &fb.GetApi217BucketReplicaLinksParams{Ids: &[]string{id}}
+	resp, err := c.GetApi217BucketReplicaLinksWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetBucketReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// CreateBucketReplicaLink replicates a local bucket to a bucket on the remote that the remote
+// credentials belong to.
+func (c *Client) CreateBucketReplicaLink(ctx context.Context, localBucket, remoteBucket, remoteCredentials string, body *fb.BucketReplicaLinkPost) (*fb.BucketReplicaLink, error) {
+	params := &fb.PostApi217BucketReplicaLinksParams{
+		LocalBucketNames:       &[]string{localBucket},
+		RemoteBucketNames:      &[]string{remoteBucket},
+		RemoteCredentialsNames: &[]string{remoteCredentials},
+	}
+	resp, err := c.PostApi217BucketReplicaLinksWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateBucketReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created bucket replica link in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateBucketReplicaLink(ctx context.Context, id string, body *fb.BucketReplicaLink) (*fb.BucketReplicaLink, error) {
+	params := &fb.PatchApi217BucketReplicaLinksParams{Ids: &[]string{id}}
+	resp, err := c.PatchApi217BucketReplicaLinksWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bucket replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateBucketReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated bucket replica link in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteBucketReplicaLink(ctx context.Context, id string) error {
+	params := &fb.DeleteApi217BucketReplicaLinksParams{Ids: &[]string{id}}
+	resp, err := c.DeleteApi217BucketReplicaLinksWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete bucket replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteBucketReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetCertificateByName(ctx context.Context, name string) (*fb.Certificate, error) {
+	params := &fb.GetApi217CertificatesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217CertificatesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetCertificate", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateCertificate(ctx context.Context, name string, body *fb.CertificatePost) (*fb.Certificate, error) {
+	params := &fb.PostApi217CertificatesParams{Names: &[]string{name}}
+	resp, err := c.PostApi217CertificatesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateCertificate", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created certificate in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateCertificate(ctx context.Context, name string, body *fb.CertificatePatch) (*fb.Certificate, error) {
+	params := &fb.PatchApi217CertificatesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217CertificatesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update certificate: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateCertificate", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated certificate in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteCertificate(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217CertificatesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217CertificatesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteCertificate", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListCertificateUses returns where certificates are in use, e.g. by an array connection or a
+// directory service, following continuation tokens. Without names, the uses of all certificates are
+// returned.
+func (c *Client) ListCertificateUses(ctx context.Context, names []string) ([]fb.CertificateUse, error) {
+	params := &fb.GetApi217CertificatesUsesParams{}
+	if len(names) > 0 {
+		params.Names = &names
+	}
+	var uses []fb.CertificateUse
+	for {
+		resp, err := c.GetApi217CertificatesUsesWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list certificate uses: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListCertificateUses", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return uses, nil
+		}
+		if resp.JSON200.Items != nil {
+			uses = append(uses, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return uses, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetCertificateGroupByName(ctx context.Context, name string) (*fb.CertificateGroup, error) {
+	params := &fb.GetApi217CertificateGroupsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217CertificateGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetCertificateGroup", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateCertificateGroup(ctx context.Context, name string) (*fb.CertificateGroup, error) {
+	params := &fb.PostApi217CertificateGroupsParams{Names: &[]string{name}}
+	resp, err := c.PostApi217CertificateGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateCertificateGroup", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created certificate group in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteCertificateGroup(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217CertificateGroupsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217CertificateGroupsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteCertificateGroup", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListCertificateGroupCertificates returns the names of the certificates in a certificate group,
+// following continuation tokens.
+func (c *Client) ListCertificateGroupCertificates(ctx context.Context, groupName string) ([]string, error) {
+	params := &fb.GetApi217CertificateGroupsCertificatesParams{CertificateGroupNames: &[]string{groupName}}
+	var names []string
+	for {
+		resp, err := c.GetApi217CertificateGroupsCertificatesWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list certificate group certificates: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListCertificateGroupCertificates", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return names, nil
+		}
+		if resp.JSON200.Items != nil {
+			for _, m := range *resp.JSON200.Items {
+				if m.Member != nil && m.Member.Name != nil {
+					names = append(names, *m.Member.Name)
+				}
+			}
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return names, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+func (c *Client) AddCertificateGroupCertificates(ctx context.Context, groupName string, certNames []string) error {
+	params := &fb.PostApi217CertificateGroupsCertificatesParams{CertificateGroupNames: &[]string{groupName}, CertificateNames: &certNames}
+	resp, err := c.PostApi217CertificateGroupsCertificatesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to add certificate group certificates: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("AddCertificateGroupCertificates", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) RemoveCertificateGroupCertificates(ctx context.Context, groupName string, certNames []string) error {
+	params := &fb.DeleteApi217CertificateGroupsCertificatesParams{CertificateGroupNames: &[]string{groupName}, CertificateNames: &certNames}
+	resp, err := c.DeleteApi217CertificateGroupsCertificatesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to remove certificate group certificates: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveCertificateGroupCertificates", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
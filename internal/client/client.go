@@ -69,6 +69,14 @@ func newApiError(op string, resp *http.Response, body []byte) error {
 	return fmt.Errorf("API Error during %s: status %s, body: %s", op, resp.Status, string(body))
 }
 
+// FilterEquals returns a filter expression matching field against a string value. Backslashes and
+// single quotes in the value are escaped, so a name can't end the literal early.
+func FilterEquals(field, value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return fmt.Sprintf("%s='%s'", field, value)
+}
+
 func (c *Client) GetFileSystemByName(ctx context.Context, name string) (*fb.FileSystem, error) {
 	params := &fb.GetApi217FileSystemsParams{Names: &[]string{name}}
 	resp, err := c.GetApi217FileSystemsWithResponse(ctx, params)
@@ -84,8 +92,13 @@ func (c *Client) GetFileSystemByName(ctx context.Context, name string) (*fb.File
 	return &(*resp.JSON200.Items)[0], nil
 }
 
-func (c *Client) CreateFileSystem(ctx context.Context, name string, fs *fb.FileSystemPost) (*fb.FileSystem, error) {
+// CreateFileSystem creates a file system. Any policyNames are attached as part of the same
+// request, so the file system is never left unprotected.
+func (c *Client) CreateFileSystem(ctx context.Context, name string, fs *fb.FileSystemPost, policyNames []string) (*fb.FileSystem, error) {
 	params := &fb.PostApi217FileSystemsParams{Names: []string{name}}
+	if len(policyNames) > 0 {
+		params.PolicyNames = &policyNames
+	}
 	resp, err := c.PostApi217FileSystemsWithResponse(ctx, params, *fs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create file system: %w", err)
@@ -99,8 +112,13 @@ func (c *Client) CreateFileSystem(ctx context.Context, name string, fs *fb.FileS
 	return &(*resp.JSON200.Items)[0], nil
 }
 
-func (c *Client) UpdateFileSystem(ctx context.Context, name string, fs *fb.FileSystemPatch) (*fb.FileSystem, error) {
+// UpdateFileSystem patches a file system. discardNonSnapshottedData must be set to demote a file
+// system, which throws away anything written since its last replicated snapshot.
+func (c *Client) UpdateFileSystem(ctx context.Context, name string, fs *fb.FileSystemPatch, discardNonSnapshottedData bool) (*fb.FileSystem, error) {
 	params := &fb.PatchApi217FileSystemsParams{Names: &[]string{name}}
+	if discardNonSnapshottedData {
+		params.DiscardNonSnapshottedData = &discardNonSnapshottedData
+	}
 	resp, err := c.PatchApi217FileSystemsWithResponse(ctx, params, *fs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update file system: %w", err)
@@ -114,6 +132,24 @@ func (c *Client) UpdateFileSystem(ctx context.Context, name string, fs *fb.FileS
 	return &(*resp.JSON200.Items)[0], nil
 }
 
+// RestoreFileSystemFromSnapshot overwrites an existing file system with the contents of a
+// snapshot. Any data written since the snapshot was taken is irretrievably lost.
+func (c *Client) RestoreFileSystemFromSnapshot(ctx context.Context, name, snapshotName string) (*fb.FileSystem, error) {
+	overwrite, discard := true, true
+	params := &fb.PostApi217FileSystemsParams{Names: []string{name}, Overwrite: &overwrite, DiscardNonSnapshottedData: &discard}
+	resp, err := c.PostApi217FileSystemsWithResponse(ctx, params, fb.FileSystemPost{Source: &fb.Reference{Name: &snapshotName}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore file system from snapshot: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("RestoreFileSystemFromSnapshot", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return restored file system in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
 func (c *Client) EradicateFileSystem(ctx context.Context, name string) error {
 	params := &fb.DeleteApi217FileSystemsParams{Names: &[]string{name}}
 	resp, err := c.DeleteApi217FileSystemsWithResponse(ctx, params)
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// GetDirectoryServiceByName returns one of the fixed directory service configurations of the
+// array, `management`, `nfs` or `smb`.
+func (c *Client) GetDirectoryServiceByName(ctx context.Context, name string) (*fb.DirectoryService, error) {
+	params := &fb.GetApi217DirectoryServicesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217DirectoryServicesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get directory service: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetDirectoryService", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateDirectoryService(ctx context.Context, name string, body *fb.DirectoryService) (*fb.DirectoryService, error) {
+	params := &fb.PatchApi217DirectoryServicesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217DirectoryServicesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update directory service: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateDirectoryService", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated directory service in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// TestDirectoryService checks that the array can reach and bind to the LDAP servers of a directory
+// service configuration.
+func (c *Client) TestDirectoryService(ctx context.Context, name string) ([]fb.TestResult, error) {
+	resp, err := c.GetApi217DirectoryServicesTestWithResponse(ctx, &fb.GetApi217DirectoryServicesTestParams{Names: &[]string{name}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test directory service: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestDirectoryService", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetDirectoryServiceRoleByName(ctx context.Context, name string) (*fb.DirectoryServiceRole, error) {
+	params := &fb.GetApi217DirectoryServicesRolesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217DirectoryServicesRolesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get directory service role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetDirectoryServiceRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateDirectoryServiceRole(ctx context.Context, name string, body *fb.DirectoryServiceRole) (*fb.DirectoryServiceRole, error) {
+	params := &fb.PostApi217DirectoryServicesRolesParams{Names: &[]string{name}}
+	resp, err := c.PostApi217DirectoryServicesRolesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory service role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateDirectoryServiceRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created directory service role in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateDirectoryServiceRole(ctx context.Context, name string, body *fb.DirectoryServiceRole) (*fb.DirectoryServiceRole, error) {
+	params := &fb.PatchApi217DirectoryServicesRolesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217DirectoryServicesRolesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update directory service role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateDirectoryServiceRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated directory service role in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteDirectoryServiceRole(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217DirectoryServicesRolesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217DirectoryServicesRolesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete directory service role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteDirectoryServiceRole", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// GetDns returns the DNS configuration of the array. Arrays always have one, so this never
+// returns nil without an error.
+func (c *Client) GetDns(ctx context.Context) (*fb.Dns, error) {
+	resp, err := c.GetApi217DnsWithResponse(ctx, &fb.GetApi217DnsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DNS: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetDns", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return a DNS configuration in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateDns(ctx context.Context, name string, body *fb.Dns) (*fb.Dns, error) {
+	params := &fb.PatchApi217DnsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217DnsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update DNS: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateDns", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated DNS in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetFileSystemAuditPolicyByName(ctx context.Context, name string) (*fb.AuditFileSystemsPolicy, error) {
+	params := &fb.GetApi217AuditFileSystemsPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217AuditFileSystemsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file system audit policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateFileSystemAuditPolicy(ctx context.Context, name string, body *fb.AuditFileSystemsPoliciesPost) (*fb.AuditFileSystemsPolicy, error) {
+	params := &fb.PostApi217AuditFileSystemsPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217AuditFileSystemsPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file system audit policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created file system audit policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateFileSystemAuditPolicy(ctx context.Context, name string, body *fb.AuditFileSystemsPoliciesPatch) (*fb.AuditFileSystemsPolicy, error) {
+	params := &fb.PatchApi217AuditFileSystemsPoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217AuditFileSystemsPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update file system audit policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated file system audit policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteFileSystemAuditPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217AuditFileSystemsPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217AuditFileSystemsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete file system audit policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetFileSystemAuditPolicy(ctx context.Context, policyName, fsName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.GetApi217FileSystemsAuditPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.GetApi217FileSystemsAuditPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file system audit policy membership: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) AddFileSystemAuditPolicy(ctx context.Context, policyName, fsName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.PostApi217FileSystemsAuditPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.PostApi217FileSystemsAuditPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add file system audit policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("AddFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return file system audit policy membership in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) RemoveFileSystemAuditPolicy(ctx context.Context, policyName, fsName string) error {
+	params := &fb.DeleteApi217FileSystemsAuditPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.DeleteApi217FileSystemsAuditPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to remove file system audit policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveFileSystemAuditPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetFileSystemReplicaLinkByID(ctx context.Context, id string) (*fb.FileSystemReplicaLink, error) {
+	params := &fb.GetApi217FileSystemReplicaLinksParams{Ids: &[]string{id}}
+	resp, err := c.GetApi217FileSystemReplicaLinksWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file system replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetFileSystemReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// CreateFileSystemReplicaLink replicates a local file system to a file system on the remote
+// array, which the remote array creates if it does not exist yet.
+func (c *Client) CreateFileSystemReplicaLink(ctx context.Context, localFileSystem, remote, remoteFileSystem string, policyNames []string) (*fb.FileSystemReplicaLink, error) {
+	params := &fb.PostApi217FileSystemReplicaLinksParams{
+		LocalFileSystemNames:  &[]string{localFileSystem},
+		RemoteNames:           &[]string{remote},
+		RemoteFileSystemNames: &[]string{remoteFileSystem},
+	}
+	body := fb.FileSystemReplicaLink{}
+	if len(policyNames) > 0 {
+		policies := make([]fb.LocationReference, 0, len(policyNames))
+		for _, name := range policyNames {
+			policies = append(policies, fb.LocationReference{Name: &name})
+		}
+		body.Policies = &policies
+	}
+	resp, err := c.PostApi217FileSystemReplicaLinksWithResponse(ctx, params, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file system replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateFileSystemReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created file system replica link in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteFileSystemReplicaLink(ctx context.Context, id string, cancelInProgressTransfers bool) error {
+	params := &fb.DeleteApi217FileSystemReplicaLinksParams{Ids: &[]string{id}, CancelInProgressTransfers: &cancelInProgressTransfers}
+	resp, err := c.DeleteApi217FileSystemReplicaLinksWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete file system replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteFileSystemReplicaLink", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// AddFileSystemReplicaLinkPolicy attaches a replication policy to the link between a local file
+// system and a remote array.
+func (c *Client) AddFileSystemReplicaLinkPolicy(ctx context.Context, localFileSystem, remote, policyName string) error {
+	params := &fb.PostApi217FileSystemReplicaLinksPoliciesParams{
+		LocalFileSystemNames: &[]string{localFileSystem},
+		RemoteNames:          &[]string{remote},
+		PolicyNames:          &[]string{policyName},
+	}
+	resp, err := c.PostApi217FileSystemReplicaLinksPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to attach policy to file system replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("AddFileSystemReplicaLinkPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) RemoveFileSystemReplicaLinkPolicy(ctx context.Context, localFileSystem, remote, policyName string) error {
+	params := &fb.DeleteApi217FileSystemReplicaLinksPoliciesParams{
+		LocalFileSystemNames: &[]string{localFileSystem},
+		RemoteNames:          &[]string{remote},
+		PolicyNames:          &[]string{policyName},
+	}
+	resp, err := c.DeleteApi217FileSystemReplicaLinksPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to detach policy from file system replica link: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveFileSystemReplicaLinkPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetFileSystemSnapshotByName(ctx context.Context, name string) (*fb.FileSystemSnapshot, error) {
+	params := &fb.GetApi217FileSystemSnapshotsParams{NamesOrOwnerNames: &[]string{name}}
+	resp, err := c.GetApi217FileSystemSnapshotsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file system snapshot: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetFileSystemSnapshot", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	// names_or_owner_names also matches every snapshot of a file system with that name.
+	for _, snap := range *resp.JSON200.Items {
+		if snap.Name != nil && *snap.Name == name {
+			return &snap, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListFileSystemSnapshots returns all snapshots matching params, following continuation tokens.
+func (c *Client) ListFileSystemSnapshots(ctx context.Context, params *fb.GetApi217FileSystemSnapshotsParams) ([]fb.FileSystemSnapshot, error) {
+	var snapshots []fb.FileSystemSnapshot
+	for {
+		resp, err := c.GetApi217FileSystemSnapshotsWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list file system snapshots: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListFileSystemSnapshots", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return snapshots, nil
+		}
+		if resp.JSON200.Items != nil {
+			snapshots = append(snapshots, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return snapshots, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+// CreateFileSystemSnapshot takes a snapshot of sourceName. If send is true the snapshot is also
+// replicated to targets, which may be empty to use every replica link of the file system.
+func (c *Client) CreateFileSystemSnapshot(ctx context.Context, sourceName string, suffix *string, send bool, targets []string) (*fb.FileSystemSnapshot, error) {
+	params := &fb.PostApi217FileSystemSnapshotsParams{SourceNames: &[]string{sourceName}}
+	if send {
+		params.Send = &send
+		if len(targets) > 0 {
+			params.Targets = &targets
+		}
+	}
+	resp, err := c.PostApi217FileSystemSnapshotsWithResponse(ctx, params, fb.FileSystemSnapshotPost{Suffix: suffix})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file system snapshot: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateFileSystemSnapshot", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created file system snapshot in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateFileSystemSnapshot(ctx context.Context, name string, snap *fb.FileSystemSnapshot) (*fb.FileSystemSnapshot, error) {
+	params := &fb.PatchApi217FileSystemSnapshotsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217FileSystemSnapshotsWithResponse(ctx, params, *snap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update file system snapshot: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateFileSystemSnapshot", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated file system snapshot in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) EradicateFileSystemSnapshot(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217FileSystemSnapshotsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217FileSystemSnapshotsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to eradicate file system snapshot: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("EradicateFileSystemSnapshot", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// ListKeytabsByPrefix returns the keytabs created together under a name prefix. Each one holds the
+// key of a single service principal and encryption type.
+func (c *Client) ListKeytabsByPrefix(ctx context.Context, prefix string) ([]fb.Keytab, error) {
+	filter := FilterEquals("prefix", prefix)
+	resp, err := c.GetApi217KeytabsWithResponse(ctx, &fb.GetApi217KeytabsParams{Filter: &filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keytabs: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("ListKeytabs", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
+
+// CreateKeytabs generates keytabs for all service principals of an Active Directory computer
+// account. This rotates the keys of the account.
+func (c *Client) CreateKeytabs(ctx context.Context, prefix, source string) ([]fb.Keytab, error) {
+	params := &fb.PostApi217KeytabsParams{NamePrefixes: &prefix}
+	body := fb.KeytabPost{Source: &fb.Reference{Name: &source}}
+	resp, err := c.PostApi217KeytabsWithResponse(ctx, params, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create keytabs: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateKeytabs", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created keytabs in response")
+	}
+	return *resp.JSON200.Items, nil
+}
+
+// UploadKeytabs imports the keys of an existing keytab file.
+func (c *Client) UploadKeytabs(ctx context.Context, prefix string, file []byte) ([]fb.Keytab, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("keytab_file", "keytab")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build keytab upload: %w", err)
+	}
+	if _, err := part.Write(file); err != nil {
+		return nil, fmt.Errorf("failed to build keytab upload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build keytab upload: %w", err)
+	}
+
+	params := &fb.PostApi217KeytabsUploadParams{NamePrefixes: &prefix}
+	resp, err := c.PostApi217KeytabsUploadWithBodyWithResponse(ctx, params, writer.FormDataContentType(), &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload keytab: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UploadKeytabs", resp.HTTPResponse, resp.Body)
+	}
+	// The SDK doesn't decode the response of multipart requests.
+	var uploaded fb.KeytabResponse
+	if err := json.Unmarshal(resp.Body, &uploaded); err != nil {
+		return nil, fmt.Errorf("failed to decode uploaded keytabs: %w", err)
+	}
+	if uploaded.Items == nil || len(*uploaded.Items) == 0 {
+		return nil, fmt.Errorf("API did not return uploaded keytabs in response")
+	}
+	return *uploaded.Items, nil
+}
+
+// DownloadKeytabs returns a keytab file holding the keys of the given keytabs.
+func (c *Client) DownloadKeytabs(ctx context.Context, names []string) ([]byte, error) {
+	resp, err := c.GetApi217KeytabsDownloadWithResponse(ctx, &fb.GetApi217KeytabsDownloadParams{KeytabNames: &names})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download keytabs: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("DownloadKeytabs", resp.HTTPResponse, resp.Body)
+	}
+	return resp.Body, nil
+}
+
+func (c *Client) DeleteKeytabs(ctx context.Context, names []string) error {
+	resp, err := c.DeleteApi217KeytabsWithResponse(ctx, &fb.DeleteApi217KeytabsParams{Names: &names})
+	if err != nil {
+		return fmt.Errorf("failed to delete keytabs: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteKeytabs", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
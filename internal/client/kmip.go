@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetKmipServerByName(ctx context.Context, name string) (*fb.KmipServer, error) {
+	params := &fb.GetApi217KmipParams{Names: &[]string{name}}
+	resp, err := c.GetApi217KmipWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KMIP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetKmipServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateKmipServer(ctx context.Context, name string, body *fb.KmipServer) (*fb.KmipServer, error) {
+	params := &fb.PostApi217KmipParams{Names: &[]string{name}}
+	resp, err := c.PostApi217KmipWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create KMIP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateKmipServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created KMIP server in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateKmipServer(ctx context.Context, name string, body *fb.KmipServer) (*fb.KmipServer, error) {
+	params := &fb.PatchApi217KmipParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217KmipWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update KMIP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateKmipServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated KMIP server in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteKmipServer(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217KmipParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217KmipWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete KMIP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteKmipServer", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// TestKmipServer checks that the array can reach the KMIP server and that it is authorized to
+// use it.
+func (c *Client) TestKmipServer(ctx context.Context, name string) ([]fb.TestResult, error) {
+	resp, err := c.GetApi217KmipTestWithResponse(ctx, &fb.GetApi217KmipTestParams{Names: &[]string{name}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test KMIP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestKmipServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
+
+// GetRapidDataLocking returns the rapid data locking configuration of the array, which always
+// exists.
+func (c *Client) GetRapidDataLocking(ctx context.Context) (*fb.RapidDataLocking, error) {
+	resp, err := c.GetApi217RapidDataLockingWithResponse(ctx, &fb.GetApi217RapidDataLockingParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rapid data locking: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetRapidDataLocking", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return rapid data locking in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateRapidDataLocking(ctx context.Context, body *fb.RapidDataLocking) (*fb.RapidDataLocking, error) {
+	resp, err := c.PatchApi217RapidDataLockingWithResponse(ctx, &fb.PatchApi217RapidDataLockingParams{}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update rapid data locking: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateRapidDataLocking", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated rapid data locking in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetLegalHoldByName(ctx context.Context, name string) (*fb.LegalHold, error) {
+	params := &fb.GetApi217LegalHoldsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217LegalHoldsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legal hold: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetLegalHold", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateLegalHold(ctx context.Context, name string, body *fb.LegalHold) (*fb.LegalHold, error) {
+	params := &fb.PostApi217LegalHoldsParams{Names: []string{name}}
+	resp, err := c.PostApi217LegalHoldsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create legal hold: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateLegalHold", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created legal hold in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateLegalHold(ctx context.Context, name string, body *fb.LegalHold) (*fb.LegalHold, error) {
+	params := &fb.PatchApi217LegalHoldsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217LegalHoldsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update legal hold: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateLegalHold", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated legal hold in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteLegalHold(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217LegalHoldsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217LegalHoldsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete legal hold: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteLegalHold", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// GetLegalHoldHeldEntity returns the entity held by a legal hold at a path within a file system, or
+// nil if the path isn't held by it.
+func (c *Client) GetLegalHoldHeldEntity(ctx context.Context, holdName, fsName, path string) (*fb.LegalHoldHeldEntity, error) {
+	params := &fb.GetApi217LegalHoldsHeldEntitiesParams{Names: &[]string{holdName}, FileSystemNames: &[]string{fsName}, Paths: &[]string{path}}
+	resp, err := c.GetApi217LegalHoldsHeldEntitiesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get legal hold held entity: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetLegalHoldHeldEntity", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) AddLegalHoldHeldEntity(ctx context.Context, holdName, fsName, path string, recursive *bool) (*fb.LegalHoldHeldEntity, error) {
+	params := &fb.PostApi217LegalHoldsHeldEntitiesParams{Names: &[]string{holdName}, FileSystemNames: &[]string{fsName}, Paths: &[]string{path}, Recursive: recursive}
+	resp, err := c.PostApi217LegalHoldsHeldEntitiesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add legal hold held entity: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("AddLegalHoldHeldEntity", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return legal hold held entity in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// ReleaseLegalHoldHeldEntity releases a path from a legal hold. Held entities can't be deleted, only released.
+func (c *Client) ReleaseLegalHoldHeldEntity(ctx context.Context, holdName, fsName, path string, recursive *bool) error {
+	params := &fb.PatchApi217LegalHoldsHeldEntitiesParams{Names: &[]string{holdName}, FileSystemNames: &[]string{fsName}, Paths: &[]string{path}, Recursive: recursive, Released: true}
+	resp, err := c.PatchApi217LegalHoldsHeldEntitiesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to release legal hold held entity: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("ReleaseLegalHoldHeldEntity", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetLinkAggregationGroupByName(ctx context.Context, name string) (*fb.LinkAggregationGroup, error) {
+	params := &fb.GetApi217LinkAggregationGroupsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217LinkAggregationGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get link aggregation group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetLinkAggregationGroup", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateLinkAggregationGroup(ctx context.Context, name string, body *fb.LinkAggregationGroup) (*fb.LinkAggregationGroup, error) {
+	params := &fb.PostApi217LinkAggregationGroupsParams{Names: []string{name}}
+	resp, err := c.PostApi217LinkAggregationGroupsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create link aggregation group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateLinkAggregationGroup", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created link aggregation group in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateLinkAggregationGroup(ctx context.Context, name string, body *fb.PatchApi217LinkAggregationGroupsJSONRequestBody) (*fb.LinkAggregationGroup, error) {
+	params := &fb.PatchApi217LinkAggregationGroupsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217LinkAggregationGroupsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update link aggregation group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateLinkAggregationGroup", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated link aggregation group in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteLinkAggregationGroup(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217LinkAggregationGroupsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217LinkAggregationGroupsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete link aggregation group: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteLinkAggregationGroup", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
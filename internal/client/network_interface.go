@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetNetworkInterfaceByName(ctx context.Context, name string) (*fb.NetworkInterface, error) {
+	params := &fb.GetApi217NetworkInterfacesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217NetworkInterfacesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateNetworkInterface(ctx context.Context, name string, body *fb.NetworkInterface) (*fb.NetworkInterface, error) {
+	params := &fb.PostApi217NetworkInterfacesParams{Names: []string{name}}
+	resp, err := c.PostApi217NetworkInterfacesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created network interface in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateNetworkInterface(ctx context.Context, name string, body *fb.NetworkInterfacePatch) (*fb.NetworkInterface, error) {
+	params := &fb.PatchApi217NetworkInterfacesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217NetworkInterfacesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated network interface in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteNetworkInterface(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217NetworkInterfacesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217NetworkInterfacesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
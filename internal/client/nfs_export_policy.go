@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetNfsExportPolicyByName(ctx context.Context, name string) (*fb.NfsExportPolicy, error) {
+	params := &fb.GetApi217NfsExportPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217NfsExportPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NFS export policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetNfsExportPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateNfsExportPolicy(ctx context.Context, name string, body *fb.NfsExportPolicyPost) (*fb.NfsExportPolicy, error) {
+	params := &fb.PostApi217NfsExportPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217NfsExportPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NFS export policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateNfsExportPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created NFS export policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateNfsExportPolicy(ctx context.Context, name string, body *fb.NfsExportPolicy) (*fb.NfsExportPolicy, error) {
+	params := &fb.PatchApi217NfsExportPoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217NfsExportPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update NFS export policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateNfsExportPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated NFS export policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteNfsExportPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217NfsExportPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217NfsExportPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete NFS export policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteNfsExportPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// GetNfsExportPolicyRuleByID looks up a rule by ID, since rule names are derived from their
+// position in the policy and may change when other rules are inserted or removed.
+func (c *Client) GetNfsExportPolicyRuleByID(ctx context.Context, id string) (*fb.NfsExportPolicyRule, error) {
+	params := &fb.GetApi217NfsExportPoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.GetApi217NfsExportPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NFS export policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetNfsExportPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// ListNfsExportPolicyRules returns the rules of a policy, ordered by index.
+func (c *Client) ListNfsExportPolicyRules(ctx context.Context, policyName string) ([]fb.NfsExportPolicyRule, error) {
+	var rules []fb.NfsExportPolicyRule
+	params := &fb.GetApi217NfsExportPoliciesRulesParams{PolicyNames: &[]string{policyName}, Sort: &[]string{"index"}}
+	for {
+		resp, err := c.GetApi217NfsExportPoliciesRulesWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list NFS export policy rules: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListNfsExportPolicyRules", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return rules, nil
+		}
+		if resp.JSON200.Items != nil {
+			rules = append(rules, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return rules, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+// CreateNfsExportPolicyRule adds a rule to a policy. If beforeRuleName is nil the rule is appended.
+func (c *Client) CreateNfsExportPolicyRule(ctx context.Context, policyName string, beforeRuleName *string, rule *fb.NfsExportPolicyRule) (*fb.NfsExportPolicyRule, error) {
+	params := &fb.PostApi217NfsExportPoliciesRulesParams{PolicyNames: &[]string{policyName}, BeforeRuleName: beforeRuleName}
+	resp, err := c.PostApi217NfsExportPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NFS export policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateNfsExportPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created NFS export policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// UpdateNfsExportPolicyRule modifies a rule. If beforeRuleName is set the rule is also moved in
+// front of that rule.
+func (c *Client) UpdateNfsExportPolicyRule(ctx context.Context, id string, beforeRuleName *string, rule *fb.NfsExportPolicyRule) (*fb.NfsExportPolicyRule, error) {
+	params := &fb.PatchApi217NfsExportPoliciesRulesParams{Ids: &[]string{id}, BeforeRuleName: beforeRuleName}
+	resp, err := c.PatchApi217NfsExportPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update NFS export policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateNfsExportPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated NFS export policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteNfsExportPolicyRule(ctx context.Context, id string) error {
+	params := &fb.DeleteApi217NfsExportPoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.DeleteApi217NfsExportPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete NFS export policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteNfsExportPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetObjectStoreAccessPolicyByName(ctx context.Context, name string) (*fb.ObjectStoreAccessPolicy, error) {
+	params := &fb.GetApi217ObjectStoreAccessPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ObjectStoreAccessPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store access policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreAccessPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateObjectStoreAccessPolicy(ctx context.Context, name string, policy *fb.ObjectStoreAccessPolicyPost) (*fb.ObjectStoreAccessPolicy, error) {
+	params := &fb.PostApi217ObjectStoreAccessPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217ObjectStoreAccessPoliciesWithResponse(ctx, params, *policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store access policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreAccessPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store access policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreAccessPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217ObjectStoreAccessPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217ObjectStoreAccessPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store access policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreAccessPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetObjectStoreAccessPolicyRule(ctx context.Context, policyName, ruleName string) (*fb.PolicyRuleObjectAccess, error) {
+	params := &fb.GetApi217ObjectStoreAccessPoliciesRulesParams{PolicyNames: &[]string{policyName}, Names: &[]string{ruleName}}
+	resp, err := c.GetApi217ObjectStoreAccessPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store access policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreAccessPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateObjectStoreAccessPolicyRule(ctx context.Context, policyName, ruleName string, rule *fb.PolicyRuleObjectAccessPost) (*fb.PolicyRuleObjectAccess, error) {
+	params := &fb.PostApi217ObjectStoreAccessPoliciesRulesParams{PolicyNames: &[]string{policyName}, Names: []string{ruleName}}
+	resp, err := c.PostApi217ObjectStoreAccessPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store access policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreAccessPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store access policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateObjectStoreAccessPolicyRule(ctx context.Context, policyName, ruleName string, rule *fb.PolicyRuleObjectAccessPost) (*fb.PolicyRuleObjectAccess, error) {
+	params := &fb.PatchApi217ObjectStoreAccessPoliciesRulesParams{PolicyNames: &[]string{policyName}, Names: &[]string{ruleName}}
+	resp, err := c.PatchApi217ObjectStoreAccessPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update object store access policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateObjectStoreAccessPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated object store access policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreAccessPolicyRule(ctx context.Context, policyName, ruleName string) error {
+	params := &fb.DeleteApi217ObjectStoreAccessPoliciesRulesParams{PolicyNames: &[]string{policyName}, Names: &[]string{ruleName}}
+	resp, err := c.DeleteApi217ObjectStoreAccessPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store access policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreAccessPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListObjectStoreAccessPolicyActions returns the names of all actions that can be used in
+// object store access policy rules.
+func (c *Client) ListObjectStoreAccessPolicyActions(ctx context.Context) ([]string, error) {
+	var actions []string
+	params := &fb.GetApi217ObjectStoreAccessPolicyActionsParams{}
+	for {
+		resp, err := c.GetApi217ObjectStoreAccessPolicyActionsWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list object store access policy actions: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListObjectStoreAccessPolicyActions", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return actions, nil
+		}
+		if resp.JSON200.Items != nil {
+			for _, action := range *resp.JSON200.Items {
+				if action.Name != nil {
+					actions = append(actions, *action.Name)
+				}
+			}
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return actions, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+func (c *Client) GetObjectStoreAccessPolicyUser(ctx context.Context, policyName, userName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.GetApi217ObjectStoreAccessPoliciesObjectStoreUsersParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{userName}}
+	resp, err := c.GetApi217ObjectStoreAccessPoliciesObjectStoreUsersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store access policy user: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreAccessPolicyUser", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) AddObjectStoreAccessPolicyUser(ctx context.Context, policyName, userName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.PostApi217ObjectStoreAccessPoliciesObjectStoreUsersParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{userName}}
+	resp, err := c.PostApi217ObjectStoreAccessPoliciesObjectStoreUsersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add object store access policy user: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("AddObjectStoreAccessPolicyUser", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created policy membership in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) RemoveObjectStoreAccessPolicyUser(ctx context.Context, policyName, userName string) error {
+	params := &fb.DeleteApi217ObjectStoreAccessPoliciesObjectStoreUsersParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{userName}}
+	resp, err := c.DeleteApi217ObjectStoreAccessPoliciesObjectStoreUsersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to remove object store access policy user: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveObjectStoreAccessPolicyUser", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetObjectStoreAccessPolicyRole(ctx context.Context, policyName, roleName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.GetApi217ObjectStoreAccessPoliciesObjectStoreRolesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{roleName}}
+	resp, err := c.GetApi217ObjectStoreAccessPoliciesObjectStoreRolesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store access policy role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreAccessPolicyRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) AddObjectStoreAccessPolicyRole(ctx context.Context, policyName, roleName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.PostApi217ObjectStoreAccessPoliciesObjectStoreRolesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{roleName}}
+	resp, err := c.PostApi217ObjectStoreAccessPoliciesObjectStoreRolesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add object store access policy role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("AddObjectStoreAccessPolicyRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created policy membership in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) RemoveObjectStoreAccessPolicyRole(ctx context.Context, policyName, roleName string) error {
+	params := &fb.DeleteApi217ObjectStoreAccessPoliciesObjectStoreRolesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{roleName}}
+	resp, err := c.DeleteApi217ObjectStoreAccessPoliciesObjectStoreRolesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to remove object store access policy role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveObjectStoreAccessPolicyRole", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetObjectStoreAccountByName(ctx context.Context, name string) (*fb.ObjectStoreAccount, error) {
+	params := &fb.GetApi217ObjectStoreAccountsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ObjectStoreAccountsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreAccount", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateObjectStoreAccount(ctx context.Context, name string, body *fb.ObjectStoreAccountPost) (*fb.ObjectStoreAccount, error) {
+	params := &fb.PostApi217ObjectStoreAccountsParams{Names: []string{name}}
+	resp, err := c.PostApi217ObjectStoreAccountsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreAccount", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store account in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateObjectStoreAccount(ctx context.Context, name string, body *fb.ObjectStoreAccountPatch) (*fb.ObjectStoreAccount, error) {
+	params := &fb.PatchApi217ObjectStoreAccountsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217ObjectStoreAccountsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update object store account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateObjectStoreAccount", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated object store account in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreAccount(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217ObjectStoreAccountsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217ObjectStoreAccountsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store account: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreAccount", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetObjectStoreRemoteCredentialsByName(ctx context.Context, name string) (*fb.ObjectStoreRemoteCredentials, error) {
+	params := &fb.GetApi217ObjectStoreRemoteCredentialsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ObjectStoreRemoteCredentialsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store remote credentials: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreRemoteCredentials", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateObjectStoreRemoteCredentials(ctx context.Context, name string, body *fb.ObjectStoreRemoteCredentialsPost) (*fb.ObjectStoreRemoteCredentials, error) {
+	params := &fb.PostApi217ObjectStoreRemoteCredentialsParams{Names: []string{name}}
+	resp, err := c.PostApi217ObjectStoreRemoteCredentialsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store remote credentials: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreRemoteCredentials", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store remote credentials in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateObjectStoreRemoteCredentials(ctx context.Context, name string, body *fb.ObjectStoreRemoteCredentials) (*fb.ObjectStoreRemoteCredentials, error) {
+	params := &fb.PatchApi217ObjectStoreRemoteCredentialsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217ObjectStoreRemoteCredentialsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update object store remote credentials: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateObjectStoreRemoteCredentials", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated object store remote credentials in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreRemoteCredentials(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217ObjectStoreRemoteCredentialsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217ObjectStoreRemoteCredentialsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store remote credentials: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreRemoteCredentials", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetObjectStoreRoleByName(ctx context.Context, name string) (*fb.ObjectStoreRole, error) {
+	params := &fb.GetApi217ObjectStoreRolesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ObjectStoreRolesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateObjectStoreRole(ctx context.Context, name string, role *fb.ObjectStoreRolePost) (*fb.ObjectStoreRole, error) {
+	params := &fb.PostApi217ObjectStoreRolesParams{Names: []string{name}}
+	resp, err := c.PostApi217ObjectStoreRolesWithResponse(ctx, params, *role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store role in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateObjectStoreRole(ctx context.Context, name string, role *fb.ObjectStoreRole) (*fb.ObjectStoreRole, error) {
+	params := &fb.PatchApi217ObjectStoreRolesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217ObjectStoreRolesWithResponse(ctx, params, *role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update object store role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateObjectStoreRole", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated object store role in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreRole(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217ObjectStoreRolesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217ObjectStoreRolesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store role: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreRole", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetTrustPolicyRule(ctx context.Context, roleName, ruleName string) (*fb.TrustPolicyRuleWithContext, error) {
+	params := &fb.GetApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesParams{RoleNames: &[]string{roleName}, Names: &[]string{ruleName}}
+	resp, err := c.GetApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trust policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetTrustPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// CreateTrustPolicyRule adds a rule to the trust policy of a role. If ruleName is nil the array
+// names the rule itself.
+func (c *Client) CreateTrustPolicyRule(ctx context.Context, roleName string, ruleName *string, rule *fb.TrustPolicyRulePost) (*fb.TrustPolicyRuleWithContext, error) {
+	params := &fb.PostApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesParams{RoleNames: &[]string{roleName}}
+	if ruleName != nil {
+		params.Names = &[]string{*ruleName}
+	}
+	resp, err := c.PostApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trust policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateTrustPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created trust policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateTrustPolicyRule(ctx context.Context, roleName, ruleName string, rule *fb.TrustPolicyRulePost) (*fb.TrustPolicyRuleWithContext, error) {
+	params := &fb.PatchApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesParams{RoleNames: &[]string{roleName}, Names: &[]string{ruleName}}
+	resp, err := c.PatchApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trust policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateTrustPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated trust policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteTrustPolicyRule(ctx context.Context, roleName, ruleName string) error {
+	params := &fb.DeleteApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesParams{RoleNames: &[]string{roleName}, Names: &[]string{ruleName}}
+	resp, err := c.DeleteApi217ObjectStoreRolesObjectStoreTrustPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete trust policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteTrustPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// DownloadTrustPolicy returns the IAM JSON document of a role's trust policy. The generated
+// response parser expects a JSON string rather than a document, so the raw body is used instead.
+func (c *Client) DownloadTrustPolicy(ctx context.Context, roleName string) ([]byte, error) {
+	params := &fb.GetApi217ObjectStoreRolesObjectStoreTrustPoliciesDownloadParams{RoleNames: &[]string{roleName}}
+	resp, err := c.ClientInterface.GetApi217ObjectStoreRolesObjectStoreTrustPoliciesDownload(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download trust policy: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trust policy: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newApiError("DownloadTrustPolicy", resp, body)
+	}
+	return body, nil
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetObjectStoreUserByName(ctx context.Context, name string) (*fb.ObjectStoreUser, error) {
+	params := &fb.GetApi217ObjectStoreUsersParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ObjectStoreUsersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store user: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreUser", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// CreateObjectStoreUser creates a user named "<account>/<user>". If fullAccess is set, the user is
+// granted the pure:policy/full-access policy on creation.
+func (c *Client) CreateObjectStoreUser(ctx context.Context, name string, fullAccess *bool) (*fb.ObjectStoreUser, error) {
+	params := &fb.PostApi217ObjectStoreUsersParams{Names: []string{name}, FullAccess: fullAccess}
+	resp, err := c.PostApi217ObjectStoreUsersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store user: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreUser", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store user in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreUser(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217ObjectStoreUsersParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217ObjectStoreUsersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store user: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreUser", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetObjectStoreAccessKeyByName(ctx context.Context, name string) (*fb.ObjectStoreAccessKey, error) {
+	params := &fb.GetApi217ObjectStoreAccessKeysParams{Names: &[]string{name}}
+	resp, err := c.GetApi217ObjectStoreAccessKeysWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object store access key: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetObjectStoreAccessKey", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// CreateObjectStoreAccessKey creates an access key for the given user. The array generates the key
+// pair unless both name and secret are given, in which case an existing key pair is imported.
+// The returned key is the only response that ever carries the secret access key.
+func (c *Client) CreateObjectStoreAccessKey(ctx context.Context, userName string, name, secret *string) (*fb.ObjectStoreAccessKey, error) {
+	params := &fb.PostApi217ObjectStoreAccessKeysParams{}
+	if name != nil {
+		params.Names = &[]string{*name}
+	}
+	body := fb.ObjectStoreAccessKeyPost{SecretAccessKey: secret}
+	body.User = &struct {
+		Id           *string `json:"id,omitempty"`
+		Name         *string `json:"name,omitempty"`
+		ResourceType *string `json:"resource_type,omitempty"`
+	}{Name: &userName}
+	resp, err := c.PostApi217ObjectStoreAccessKeysWithResponse(ctx, params, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store access key: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateObjectStoreAccessKey", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created object store access key in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateObjectStoreAccessKey(ctx context.Context, name string, body *fb.ObjectStoreAccessKey) (*fb.ObjectStoreAccessKey, error) {
+	params := &fb.PatchApi217ObjectStoreAccessKeysParams{Names: []string{name}}
+	resp, err := c.PatchApi217ObjectStoreAccessKeysWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update object store access key: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateObjectStoreAccessKey", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated object store access key in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteObjectStoreAccessKey(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217ObjectStoreAccessKeysParams{Names: []string{name}}
+	resp, err := c.DeleteApi217ObjectStoreAccessKeysWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete object store access key: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteObjectStoreAccessKey", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetQosPolicyByName(ctx context.Context, name string) (*fb.QosPolicy, error) {
+	params := &fb.GetApi217QosPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217QosPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get QoS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetQosPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateQosPolicy(ctx context.Context, name string, body *fb.QosPolicy) (*fb.QosPolicy, error) {
+	params := &fb.PostApi217QosPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217QosPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create QoS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateQosPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created QoS policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateQosPolicy(ctx context.Context, name string, body *fb.QosPolicy) (*fb.QosPolicy, error) {
+	params := &fb.PatchApi217QosPoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217QosPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update QoS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateQosPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated QoS policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteQosPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217QosPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217QosPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete QoS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteQosPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListQosPolicyMembers returns the file systems and buckets a QoS policy is applied to,
+// following continuation tokens.
+func (c *Client) ListQosPolicyMembers(ctx context.Context, policyName string) ([]fb.PolicyMember, error) {
+	params := &fb.GetApi217QosPoliciesMembersParams{PolicyNames: &[]string{policyName}}
+	var members []fb.PolicyMember
+	for {
+		resp, err := c.GetApi217QosPoliciesMembersWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list QoS policy members: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListQosPolicyMembers", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return members, nil
+		}
+		if resp.JSON200.Items != nil {
+			members = append(members, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return members, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// User and group quotas are addressed by file system plus either a numeric ID or a name. Callers
+// pass whichever they know; the ID is preferred, since names can be renamed in the directory.
+
+func (c *Client) GetUserQuota(ctx context.Context, fileSystem string, uid *int32, userName *string) (*fb.UserQuota, error) {
+	params := &fb.GetApi217QuotasUsersParams{FileSystemNames: &[]string{fileSystem}}
+	if uid != nil {
+		params.Uids = &[]int32{*uid}
+	} else if userName != nil {
+		params.UserNames = &[]string{*userName}
+	}
+	resp, err := c.GetApi217QuotasUsersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetUserQuota", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateUserQuota(ctx context.Context, fileSystem string, uid *int32, userName *string, quota int64) (*fb.UserQuota, error) {
+	params := &fb.PostApi217QuotasUsersParams{FileSystemNames: &[]string{fileSystem}}
+	if uid != nil {
+		params.Uids = &[]int32{*uid}
+	} else if userName != nil {
+		params.UserNames = &[]string{*userName}
+	}
+	resp, err := c.PostApi217QuotasUsersWithResponse(ctx, params, fb.UserQuotaPost{Quota: quota})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateUserQuota", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created user quota in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateUserQuota(ctx context.Context, fileSystem string, uid *int32, userName *string, quota int64) (*fb.UserQuota, error) {
+	params := &fb.PatchApi217QuotasUsersParams{FileSystemNames: &[]string{fileSystem}}
+	if uid != nil {
+		params.Uids = &[]int32{*uid}
+	} else if userName != nil {
+		params.UserNames = &[]string{*userName}
+	}
+	resp, err := c.PatchApi217QuotasUsersWithResponse(ctx, params, fb.UserQuotaPatch{Quota: quota})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateUserQuota", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated user quota in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteUserQuota(ctx context.Context, fileSystem string, uid *int32, userName *string) error {
+	params := &fb.DeleteApi217QuotasUsersParams{FileSystemNames: &[]string{fileSystem}}
+	if uid != nil {
+		params.Uids = &[]int32{*uid}
+	} else if userName != nil {
+		params.UserNames = &[]string{*userName}
+	}
+	resp, err := c.DeleteApi217QuotasUsersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete user quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteUserQuota", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetGroupQuota(ctx context.Context, fileSystem string, gid *int32, groupName *string) (*fb.GroupQuota, error) {
+	params := &fb.GetApi217QuotasGroupsParams{FileSystemNames: &[]string{fileSystem}}
+	if gid != nil {
+		params.Gids = &[]int32{*gid}
+	} else if groupName != nil {
+		params.GroupNames = &[]string{*groupName}
+	}
+	resp, err := c.GetApi217QuotasGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetGroupQuota", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateGroupQuota(ctx context.Context, fileSystem string, gid *int32, groupName *string, quota int64) (*fb.GroupQuota, error) {
+	params := &fb.PostApi217QuotasGroupsParams{FileSystemNames: &[]string{fileSystem}}
+	if gid != nil {
+		params.Gids = &[]int32{*gid}
+	} else if groupName != nil {
+		params.GroupNames = &[]string{*groupName}
+	}
+	resp, err := c.PostApi217QuotasGroupsWithResponse(ctx, params, fb.GroupQuotaPost{Quota: quota})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateGroupQuota", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created group quota in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateGroupQuota(ctx context.Context, fileSystem string, gid *int32, groupName *string, quota int64) (*fb.GroupQuota, error) {
+	params := &fb.PatchApi217QuotasGroupsParams{FileSystemNames: &[]string{fileSystem}}
+	if gid != nil {
+		params.Gids = &[]int32{*gid}
+	} else if groupName != nil {
+		params.GroupNames = &[]string{*groupName}
+	}
+	resp, err := c.PatchApi217QuotasGroupsWithResponse(ctx, params, fb.GroupQuotaPatch{Quota: quota})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update group quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateGroupQuota", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated group quota in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteGroupQuota(ctx context.Context, fileSystem string, gid *int32, groupName *string) error {
+	params := &fb.DeleteApi217QuotasGroupsParams{FileSystemNames: &[]string{fileSystem}}
+	if gid != nil {
+		params.Gids = &[]int32{*gid}
+	} else if groupName != nil {
+		params.GroupNames = &[]string{*groupName}
+	}
+	resp, err := c.DeleteApi217QuotasGroupsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete group quota: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteGroupQuota", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListUserUsage returns the space used by every user of a file system, following continuation tokens.
+func (c *Client) ListUserUsage(ctx context.Context, params *fb.GetApi217UsageUsersParams) ([]fb.UserQuota, error) {
+	var usage []fb.UserQuota
+	for {
+		resp, err := c.GetApi217UsageUsersWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user usage: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListUserUsage", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return usage, nil
+		}
+		if resp.JSON200.Items != nil {
+			usage = append(usage, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return usage, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+// ListGroupUsage returns the space used by every group of a file system, following continuation tokens.
+func (c *Client) ListGroupUsage(ctx context.Context, params *fb.GetApi217UsageGroupsParams) ([]fb.GroupQuota, error) {
+	var usage []fb.GroupQuota
+	for {
+		resp, err := c.GetApi217UsageGroupsWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list group usage: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListGroupUsage", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return usage, nil
+		}
+		if resp.JSON200.Items != nil {
+			usage = append(usage, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return usage, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+// GetQuotaSettings returns the array-wide quota settings, which always exist.
+func (c *Client) GetQuotaSettings(ctx context.Context) (*fb.QuotaSetting, error) {
+	resp, err := c.GetApi217QuotasSettingsWithResponse(ctx, &fb.GetApi217QuotasSettingsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quota settings: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetQuotaSettings", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return quota settings in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateQuotaSettings(ctx context.Context, body *fb.QuotaSetting) (*fb.QuotaSetting, error) {
+	resp, err := c.PatchApi217QuotasSettingsWithResponse(ctx, &fb.PatchApi217QuotasSettingsParams{}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quota settings: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateQuotaSettings", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated quota settings in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetSmbSharePolicyByName(ctx context.Context, name string) (*fb.SmbSharePolicy, error) {
+	params := &fb.GetApi217SmbSharePoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SmbSharePoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SMB share policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSmbSharePolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSmbSharePolicy(ctx context.Context, name string, body *fb.SmbSharePolicyPost) (*fb.SmbSharePolicy, error) {
+	params := &fb.PostApi217SmbSharePoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217SmbSharePoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SMB share policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSmbSharePolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created SMB share policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSmbSharePolicy(ctx context.Context, name string, body *fb.SmbSharePolicy) (*fb.SmbSharePolicy, error) {
+	params := &fb.PatchApi217SmbSharePoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SmbSharePoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SMB share policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSmbSharePolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SMB share policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSmbSharePolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SmbSharePoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SmbSharePoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete SMB share policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSmbSharePolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetSmbClientPolicyByName(ctx context.Context, name string) (*fb.SmbClientPolicy, error) {
+	params := &fb.GetApi217SmbClientPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SmbClientPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SMB client policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSmbClientPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSmbClientPolicy(ctx context.Context, name string, body *fb.SmbClientPolicyPost) (*fb.SmbClientPolicy, error) {
+	params := &fb.PostApi217SmbClientPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217SmbClientPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SMB client policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSmbClientPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created SMB client policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSmbClientPolicy(ctx context.Context, name string, body *fb.SmbClientPolicy) (*fb.SmbClientPolicy, error) {
+	params := &fb.PatchApi217SmbClientPoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SmbClientPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SMB client policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSmbClientPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SMB client policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSmbClientPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SmbClientPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SmbClientPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete SMB client policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSmbClientPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetSmbSharePolicyRuleByID(ctx context.Context, id string) (*fb.SmbSharePolicyRuleWithContext, error) {
+	params := &fb.GetApi217SmbSharePoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.GetApi217SmbSharePoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SMB share policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSmbSharePolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSmbSharePolicyRule(ctx context.Context, policyName string, rule *fb.SmbSharePolicyRulePost) (*fb.SmbSharePolicyRuleWithContext, error) {
+	params := &fb.PostApi217SmbSharePoliciesRulesParams{PolicyNames: &[]string{policyName}}
+	resp, err := c.PostApi217SmbSharePoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SMB share policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSmbSharePolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created SMB share policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSmbSharePolicyRule(ctx context.Context, id string, rule *fb.SmbSharePolicyRule) (*fb.SmbSharePolicyRuleWithContext, error) {
+	params := &fb.PatchApi217SmbSharePoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.PatchApi217SmbSharePoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SMB share policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSmbSharePolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SMB share policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSmbSharePolicyRule(ctx context.Context, id string) error {
+	params := &fb.DeleteApi217SmbSharePoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.DeleteApi217SmbSharePoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete SMB share policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSmbSharePolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetSmbClientPolicyRuleByID(ctx context.Context, id string) (*fb.SmbClientPolicyRule, error) {
+	params := &fb.GetApi217SmbClientPoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.GetApi217SmbClientPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SMB client policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSmbClientPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// ListSmbClientPolicyRules returns the rules of a policy, ordered by index.
+func (c *Client) ListSmbClientPolicyRules(ctx context.Context, policyName string) ([]fb.SmbClientPolicyRule, error) {
+	var rules []fb.SmbClientPolicyRule
+	params := &fb.GetApi217SmbClientPoliciesRulesParams{PolicyNames: &[]string{policyName}, Sort: &[]string{"index"}}
+	for {
+		resp, err := c.GetApi217SmbClientPoliciesRulesWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SMB client policy rules: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListSmbClientPolicyRules", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return rules, nil
+		}
+		if resp.JSON200.Items != nil {
+			rules = append(rules, *resp.JSON200.Items...)
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return rules, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+// CreateSmbClientPolicyRule adds a rule to a policy. If beforeRuleName is nil the rule is appended.
+func (c *Client) CreateSmbClientPolicyRule(ctx context.Context, policyName string, beforeRuleName *string, rule *fb.SmbClientPolicyRulePost) (*fb.SmbClientPolicyRule, error) {
+	params := &fb.PostApi217SmbClientPoliciesRulesParams{PolicyNames: &[]string{policyName}, BeforeRuleName: beforeRuleName}
+	resp, err := c.PostApi217SmbClientPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SMB client policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSmbClientPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created SMB client policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// UpdateSmbClientPolicyRule modifies a rule. If beforeRuleName is set the rule is also moved in
+// front of that rule.
+func (c *Client) UpdateSmbClientPolicyRule(ctx context.Context, id string, beforeRuleName *string, rule *fb.SmbClientPolicyRule) (*fb.SmbClientPolicyRule, error) {
+	params := &fb.PatchApi217SmbClientPoliciesRulesParams{Ids: &[]string{id}, BeforeRuleName: beforeRuleName}
+	resp, err := c.PatchApi217SmbClientPoliciesRulesWithResponse(ctx, params, *rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SMB client policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSmbClientPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SMB client policy rule in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSmbClientPolicyRule(ctx context.Context, id string) error {
+	params := &fb.DeleteApi217SmbClientPoliciesRulesParams{Ids: &[]string{id}}
+	resp, err := c.DeleteApi217SmbClientPoliciesRulesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete SMB client policy rule: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSmbClientPolicyRule", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
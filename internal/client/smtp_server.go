@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// GetSmtpServer returns the SMTP relay configuration of the array, which always exists.
+func (c *Client) GetSmtpServer(ctx context.Context) (*fb.SmtpServer, error) {
+	resp, err := c.GetApi217SmtpServersWithResponse(ctx, &fb.GetApi217SmtpServersParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SMTP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSmtpServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return the SMTP server in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSmtpServer(ctx context.Context, body *fb.SmtpServer) (*fb.SmtpServer, error) {
+	resp, err := c.PatchApi217SmtpServersWithResponse(ctx, &fb.PatchApi217SmtpServersParams{}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SMTP server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSmtpServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SMTP server in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
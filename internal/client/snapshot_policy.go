@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetSnapshotPolicyByName(ctx context.Context, name string) (*fb.Policy, error) {
+	params := &fb.GetApi217PoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217PoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSnapshotPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSnapshotPolicy(ctx context.Context, name string, body *fb.Policy) (*fb.Policy, error) {
+	params := &fb.PostApi217PoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217PoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSnapshotPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created snapshot policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// UpdateSnapshotPolicy modifies a snapshot policy. destroySnapshots must be true for changes that
+// would destroy existing snapshots, such as shortening keep_for.
+func (c *Client) UpdateSnapshotPolicy(ctx context.Context, name string, body *fb.PolicyPatch, destroySnapshots bool) (*fb.Policy, error) {
+	params := &fb.PatchApi217PoliciesParams{Names: &[]string{name}, DestroySnapshots: &destroySnapshots}
+	resp, err := c.PatchApi217PoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update snapshot policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSnapshotPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated snapshot policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSnapshotPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217PoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217PoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSnapshotPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) GetFileSystemPolicy(ctx context.Context, policyName, fsName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.GetApi217FileSystemsPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.GetApi217FileSystemsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file system policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetFileSystemPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+// snapshotPolicyResourceType is the resource type of snapshot policies in policy references.
+const snapshotPolicyResourceType = "policies"
+
+// ListFileSystemSnapshotPolicies returns the names of the snapshot policies attached to a file system,
+// following continuation tokens. Other policies attached through the same endpoint, such as WORM data
+// policies, are left out.
+func (c *Client) ListFileSystemSnapshotPolicies(ctx context.Context, fsName string) ([]string, error) {
+	params := &fb.GetApi217FileSystemsPoliciesParams{MemberNames: &[]string{fsName}}
+	var names []string
+	for {
+		resp, err := c.GetApi217FileSystemsPoliciesWithResponse(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list file system policies: %w", err)
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, newApiError("ListFileSystemSnapshotPolicies", resp.HTTPResponse, resp.Body)
+		}
+		if resp.JSON200 == nil {
+			return names, nil
+		}
+		if resp.JSON200.Items != nil {
+			for _, m := range *resp.JSON200.Items {
+				if m.Policy != nil && m.Policy.Name != nil && m.Policy.ResourceType != nil && *m.Policy.ResourceType == snapshotPolicyResourceType {
+					names = append(names, *m.Policy.Name)
+				}
+			}
+		}
+		if resp.JSON200.ContinuationToken == nil || *resp.JSON200.ContinuationToken == "" {
+			return names, nil
+		}
+		params.ContinuationToken = resp.JSON200.ContinuationToken
+	}
+}
+
+func (c *Client) AddFileSystemPolicy(ctx context.Context, policyName, fsName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.PostApi217FileSystemsPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.PostApi217FileSystemsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add file system policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("AddFileSystemPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return file system policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) RemoveFileSystemPolicy(ctx context.Context, policyName, fsName string) error {
+	params := &fb.DeleteApi217FileSystemsPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.DeleteApi217FileSystemsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to remove file system policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveFileSystemPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
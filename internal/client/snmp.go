@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetSnmpManagerByName(ctx context.Context, name string) (*fb.SnmpManager, error) {
+	params := &fb.GetApi217SnmpManagersParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SnmpManagersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SNMP manager: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSnmpManager", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSnmpManager(ctx context.Context, name string, body *fb.SnmpManagerPost) (*fb.SnmpManager, error) {
+	params := &fb.PostApi217SnmpManagersParams{Names: []string{name}}
+	resp, err := c.PostApi217SnmpManagersWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SNMP manager: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSnmpManager", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created SNMP manager in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSnmpManager(ctx context.Context, name string, body *fb.SnmpManager) (*fb.SnmpManager, error) {
+	params := &fb.PatchApi217SnmpManagersParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SnmpManagersWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SNMP manager: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSnmpManager", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SNMP manager in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSnmpManager(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SnmpManagersParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SnmpManagersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete SNMP manager: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSnmpManager", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) TestSnmpManager(ctx context.Context, name string) ([]fb.TestResult, error) {
+	resp, err := c.GetApi217SnmpManagersTestWithResponse(ctx, &fb.GetApi217SnmpManagersTestParams{Names: &[]string{name}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test SNMP manager: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestSnmpManager", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
+
+// GetSnmpAgent returns the SNMP agent of the array, which always exists.
+func (c *Client) GetSnmpAgent(ctx context.Context) (*fb.SnmpAgent, error) {
+	resp, err := c.GetApi217SnmpAgentsWithResponse(ctx, &fb.GetApi217SnmpAgentsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SNMP agent: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSnmpAgent", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return the SNMP agent in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSnmpAgent(ctx context.Context, body *fb.SnmpAgent) (*fb.SnmpAgent, error) {
+	resp, err := c.PatchApi217SnmpAgentsWithResponse(ctx, &fb.PatchApi217SnmpAgentsParams{}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SNMP agent: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSnmpAgent", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SNMP agent in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
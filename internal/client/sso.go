@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetSsoSaml2IdpByName(ctx context.Context, name string) (*fb.Saml2Sso, error) {
+	params := &fb.GetApi217SsoSaml2IdpsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SsoSaml2IdpsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SAML2 SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSsoSaml2Idp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSsoSaml2Idp(ctx context.Context, name string, body *fb.Saml2SsoPost) (*fb.Saml2Sso, error) {
+	params := &fb.PostApi217SsoSaml2IdpsParams{Names: []string{name}}
+	resp, err := c.PostApi217SsoSaml2IdpsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SAML2 SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSsoSaml2Idp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created SAML2 SSO identity provider in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSsoSaml2Idp(ctx context.Context, name string, body *fb.Saml2Sso) (*fb.Saml2Sso, error) {
+	params := &fb.PatchApi217SsoSaml2IdpsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SsoSaml2IdpsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update SAML2 SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSsoSaml2Idp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated SAML2 SSO identity provider in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSsoSaml2Idp(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SsoSaml2IdpsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SsoSaml2IdpsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete SAML2 SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSsoSaml2Idp", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// TestSsoSaml2Idp checks that the array can reach the SAML2 identity provider and that its
+// configuration is usable.
+func (c *Client) TestSsoSaml2Idp(ctx context.Context, name string) ([]fb.TestResult, error) {
+	resp, err := c.GetApi217SsoSaml2IdpsTestWithResponse(ctx, &fb.GetApi217SsoSaml2IdpsTestParams{Names: &[]string{name}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test SAML2 SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestSsoSaml2Idp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
+
+// TestSsoSaml2IdpChanges is TestSsoSaml2Idp for changes to an existing identity provider, without
+// applying them.
+func (c *Client) TestSsoSaml2IdpChanges(ctx context.Context, name string, body *fb.Saml2Sso) ([]fb.TestResult, error) {
+	resp, err := c.PatchApi217SsoSaml2IdpsTestWithResponse(ctx, &fb.PatchApi217SsoSaml2IdpsTestParams{Names: &[]string{name}}, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to test SAML2 SSO identity provider changes: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestSsoSaml2IdpChanges", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
+
+func (c *Client) GetSsoOidcIdpByName(ctx context.Context, name string) (*fb.OidcSso, error) {
+	params := &fb.GetApi217SsoOidcIdpsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SsoOidcIdpsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSsoOidcIdp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSsoOidcIdp(ctx context.Context, name string, body *fb.OidcSsoPost) (*fb.OidcSso, error) {
+	params := &fb.PostApi217SsoOidcIdpsParams{Names: &[]string{name}}
+	resp, err := c.PostApi217SsoOidcIdpsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OIDC SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSsoOidcIdp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created OIDC SSO identity provider in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSsoOidcIdp(ctx context.Context, name string, body *fb.OidcSsoPatch) (*fb.OidcSso, error) {
+	params := &fb.PatchApi217SsoOidcIdpsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SsoOidcIdpsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update OIDC SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSsoOidcIdp", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated OIDC SSO identity provider in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSsoOidcIdp(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SsoOidcIdpsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SsoOidcIdpsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete OIDC SSO identity provider: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSsoOidcIdp", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
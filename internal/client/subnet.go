@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetSubnetByName(ctx context.Context, name string) (*fb.Subnet, error) {
+	params := &fb.GetApi217SubnetsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SubnetsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subnet: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSubnet", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateSubnet(ctx context.Context, name string, body *fb.Subnet) (*fb.Subnet, error) {
+	params := &fb.PostApi217SubnetsParams{Names: []string{name}}
+	resp, err := c.PostApi217SubnetsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subnet: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSubnet", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created subnet in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSubnet(ctx context.Context, name string, body *fb.Subnet) (*fb.Subnet, error) {
+	params := &fb.PatchApi217SubnetsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SubnetsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update subnet: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSubnet", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated subnet in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSubnet(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SubnetsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SubnetsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete subnet: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSubnet", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListSubnetsByLinkAggregationGroup returns the subnets configured on a link aggregation group.
+func (c *Client) ListSubnetsByLinkAggregationGroup(ctx context.Context, lagName string) ([]fb.Subnet, error) {
+	filter := FilterEquals("link_aggregation_group.name", lagName)
+	resp, err := c.GetApi217SubnetsWithResponse(ctx, &fb.GetApi217SubnetsParams{Filter: &filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subnets: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("ListSubnets", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetSyslogServerByName(ctx context.Context, name string) (*fb.SyslogServer, error) {
+	params := &fb.GetApi217SyslogServersParams{Names: &[]string{name}}
+	resp, err := c.GetApi217SyslogServersWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get syslog server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSyslogServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	// GET responses carry the fleet context; drop it so callers deal with a single type.
+	s := (*resp.JSON200.Items)[0]
+	return &fb.SyslogServer{Id: s.Id, Name: s.Name, Services: s.Services, Uri: s.Uri}, nil
+}
+
+func (c *Client) CreateSyslogServer(ctx context.Context, name string, body *fb.SyslogServerPost) (*fb.SyslogServer, error) {
+	params := &fb.PostApi217SyslogServersParams{Names: &[]string{name}}
+	resp, err := c.PostApi217SyslogServersWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create syslog server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateSyslogServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created syslog server in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSyslogServer(ctx context.Context, name string, body *fb.SyslogServerPatch) (*fb.SyslogServer, error) {
+	params := &fb.PatchApi217SyslogServersParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SyslogServersWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update syslog server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSyslogServer", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated syslog server in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteSyslogServer(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217SyslogServersParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217SyslogServersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete syslog server: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteSyslogServer", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// TestSyslogServers sends a test message to every configured syslog server. The API can't test a
+// single server, so callers pick the results they are interested in.
+func (c *Client) TestSyslogServers(ctx context.Context) ([]fb.TestResult, error) {
+	resp, err := c.GetApi217SyslogServersTestWithResponse(ctx, &fb.GetApi217SyslogServersTestParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to test syslog servers: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("TestSyslogServers", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil {
+		return nil, nil
+	}
+	return *resp.JSON200.Items, nil
+}
+
+// GetSyslogServerSettings returns the array-wide syslog settings, which always exist.
+func (c *Client) GetSyslogServerSettings(ctx context.Context) (*fb.SyslogServerSettings, error) {
+	resp, err := c.GetApi217SyslogServersSettingsWithResponse(ctx, &fb.GetApi217SyslogServersSettingsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get syslog server settings: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetSyslogServerSettings", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return syslog server settings in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateSyslogServerSettings(ctx context.Context, name string, body *fb.SyslogServerSettings) (*fb.SyslogServerSettings, error) {
+	params := &fb.PatchApi217SyslogServersSettingsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217SyslogServersSettingsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update syslog server settings: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateSyslogServerSettings", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated syslog server settings in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetTargetByName(ctx context.Context, name string) (*fb.Target, error) {
+	params := &fb.GetApi217TargetsParams{Names: &[]string{name}}
+	resp, err := c.GetApi217TargetsWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetTarget", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	// GET responses carry the fleet context; drop it so callers deal with a single type.
+	t := (*resp.JSON200.Items)[0]
+	return &fb.Target{Address: t.Address, CaCertificateGroup: t.CaCertificateGroup, Id: t.Id, Name: t.Name, Status: t.Status, StatusDetails: t.StatusDetails}, nil
+}
+
+func (c *Client) CreateTarget(ctx context.Context, name string, body *fb.TargetPost) (*fb.Target, error) {
+	params := &fb.PostApi217TargetsParams{Names: []string{name}}
+	resp, err := c.PostApi217TargetsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create target: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateTarget", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created target in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateTarget(ctx context.Context, name string, body *fb.Target) (*fb.Target, error) {
+	params := &fb.PatchApi217TargetsParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217TargetsWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update target: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateTarget", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated target in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteTarget(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217TargetsParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217TargetsWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete target: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteTarget", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetTlsPolicyByName(ctx context.Context, name string) (*fb.TlsPolicy, error) {
+	params := &fb.GetApi217TlsPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217TlsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TLS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetTlsPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateTlsPolicy(ctx context.Context, name string, body *fb.TlsPolicyPost) (*fb.TlsPolicy, error) {
+	params := &fb.PostApi217TlsPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217TlsPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TLS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateTlsPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created TLS policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateTlsPolicy(ctx context.Context, name string, body *fb.TlsPolicy) (*fb.TlsPolicy, error) {
+	params := &fb.PatchApi217TlsPoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217TlsPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update TLS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateTlsPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated TLS policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteTlsPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217TlsPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217TlsPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete TLS policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteTlsPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// GetTlsPolicyNetworkInterface returns the membership of a network interface in a TLS policy, or nil
+// if the policy isn't applied to it.
+func (c *Client) GetTlsPolicyNetworkInterface(ctx context.Context, policyName, interfaceName string) (*fb.PolicyMember, error) {
+	params := &fb.GetApi217TlsPoliciesNetworkInterfacesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{interfaceName}}
+	resp, err := c.GetApi217TlsPoliciesNetworkInterfacesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TLS policy network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetTlsPolicyNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) AddTlsPolicyNetworkInterface(ctx context.Context, policyName, interfaceName string) error {
+	params := &fb.PostApi217TlsPoliciesNetworkInterfacesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{interfaceName}}
+	resp, err := c.PostApi217TlsPoliciesNetworkInterfacesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to add TLS policy network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("AddTlsPolicyNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func (c *Client) RemoveTlsPolicyNetworkInterface(ctx context.Context, policyName, interfaceName string) error {
+	params := &fb.DeleteApi217TlsPoliciesNetworkInterfacesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{interfaceName}}
+	resp, err := c.DeleteApi217TlsPoliciesNetworkInterfacesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to remove TLS policy network interface: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("RemoveTlsPolicyNetworkInterface", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
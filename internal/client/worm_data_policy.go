@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+func (c *Client) GetWormDataPolicyByName(ctx context.Context, name string) (*fb.WormDataPolicy, error) {
+	params := &fb.GetApi217WormDataPoliciesParams{Names: &[]string{name}}
+	resp, err := c.GetApi217WormDataPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get WORM data policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetWormDataPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) CreateWormDataPolicy(ctx context.Context, name string, body *fb.WormDataPolicy) (*fb.WormDataPolicy, error) {
+	params := &fb.PostApi217WormDataPoliciesParams{Names: []string{name}}
+	resp, err := c.PostApi217WormDataPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create WORM data policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("CreateWormDataPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return created WORM data policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) UpdateWormDataPolicy(ctx context.Context, name string, body *fb.WormDataPolicy) (*fb.WormDataPolicy, error) {
+	params := &fb.PatchApi217WormDataPoliciesParams{Names: &[]string{name}}
+	resp, err := c.PatchApi217WormDataPoliciesWithResponse(ctx, params, *body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update WORM data policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("UpdateWormDataPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, fmt.Errorf("API did not return updated WORM data policy in response")
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
+
+func (c *Client) DeleteWormDataPolicy(ctx context.Context, name string) error {
+	params := &fb.DeleteApi217WormDataPoliciesParams{Names: &[]string{name}}
+	resp, err := c.DeleteApi217WormDataPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete WORM data policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newApiError("DeleteWormDataPolicy", resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// GetFileSystemWormDataPolicy returns the membership of a file system in a WORM data policy, or nil
+// if the policy isn't applied to it.
+func (c *Client) GetFileSystemWormDataPolicy(ctx context.Context, policyName, fsName string) (*fb.PolicyMemberContext, error) {
+	params := &fb.GetApi217FileSystemsWormDataPoliciesParams{PolicyNames: &[]string{policyName}, MemberNames: &[]string{fsName}}
+	resp, err := c.GetApi217FileSystemsWormDataPoliciesWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file system WORM data policy: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newApiError("GetFileSystemWormDataPolicy", resp.HTTPResponse, resp.Body)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Items == nil || len(*resp.JSON200.Items) == 0 {
+		return nil, nil
+	}
+	return &(*resp.JSON200.Items)[0], nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &certificateUsesDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificateUsesDataSource{}
+)
+
+var certificateUseAttributeTypes = map[string]attr.Type{
+	"certificate":       types.StringType,
+	"certificate_group": types.StringType,
+	"name":              types.StringType,
+	"resource_type":     types.StringType,
+	"remote":            types.StringType,
+}
+
+func NewCertificateUsesDataSource() datasource.DataSource {
+	return &certificateUsesDataSource{}
+}
+
+type certificateUsesDataSource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type certificateUsesDataSourceModel struct {
+	Certificate types.String `tfsdk:"certificate"`
+	Uses        types.List   `tfsdk:"uses"`
+}
+
+func (d *certificateUsesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_uses"
+}
+
+// --- SCHEMA ---
+func (d *certificateUsesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists where Pure Storage FlashBlade certificates are in use, e.g. by TLS policies, array connections or directory services, " +
+			"so the impact of replacing a certificate can be checked first.",
+		Attributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{Description: "The name of the certificate. If not set, the uses of all certificates are listed.", Optional: true},
+			"uses": schema.ListNestedAttribute{
+				Description: "The uses of the certificates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate":       schema.StringAttribute{Description: "The name of the certificate.", Computed: true},
+						"certificate_group": schema.StringAttribute{Description: "The certificate group the certificate is used through, if any.", Computed: true},
+						"name":              schema.StringAttribute{Description: "The name of the object using the certificate.", Computed: true},
+						"resource_type":     schema.StringAttribute{Description: "The type of the object using the certificate, e.g. `tls-policies` or `array-connections`.", Computed: true},
+						"remote":            schema.StringAttribute{Description: "The remote array the object belongs to, if any.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// --- READ ---
+func (d *certificateUsesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state certificateUsesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	if !state.Certificate.IsNull() {
+		names = []string{state.Certificate.ValueString()}
+	}
+	uses, err := d.client.ListCertificateUses(ctx, names)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Certificate Uses", fmt.Sprintf("Could not list certificate uses: %s", err.Error()))
+		return
+	}
+
+	items := make([]attr.Value, 0, len(uses))
+	for _, u := range uses {
+		item := map[string]attr.Value{
+			"certificate":       types.StringPointerValue(u.Name),
+			"certificate_group": types.StringNull(),
+			"name":              types.StringNull(),
+			"resource_type":     types.StringNull(),
+			"remote":            types.StringNull(),
+		}
+		if u.Group != nil {
+			item["certificate_group"] = types.StringPointerValue(u.Group.Name)
+		}
+		if u.Use != nil {
+			item["name"] = types.StringPointerValue(u.Use.Name)
+			item["resource_type"] = types.StringPointerValue(u.Use.ResourceType)
+			if u.Use.Remote != nil {
+				item["remote"] = types.StringPointerValue(u.Use.Remote.Name)
+			}
+		}
+		items = append(items, types.ObjectValueMust(certificateUseAttributeTypes, item))
+	}
+	state.Uses = types.ListValueMust(types.ObjectType{AttrTypes: certificateUseAttributeTypes}, items)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- CONFIGURE ---
+func (d *certificateUsesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = c
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &fileSystemSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &fileSystemSnapshotsDataSource{}
+)
+
+var fileSystemSnapshotAttributeTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"suffix":         types.StringType,
+	"policy_name":    types.StringType,
+	"created":        types.Int64Type,
+	"destroyed":      types.BoolType,
+	"time_remaining": types.Int64Type,
+}
+
+func NewFileSystemSnapshotsDataSource() datasource.DataSource {
+	return &fileSystemSnapshotsDataSource{}
+}
+
+type fileSystemSnapshotsDataSource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type fileSystemSnapshotsDataSourceModel struct {
+	Source    types.String `tfsdk:"source"`
+	Filter    types.String `tfsdk:"filter"`
+	Sort      types.String `tfsdk:"sort"`
+	Destroyed types.Bool   `tfsdk:"destroyed"`
+	Snapshots types.List   `tfsdk:"snapshots"`
+}
+
+func (d *fileSystemSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_system_snapshots"
+}
+
+// --- SCHEMA ---
+func (d *fileSystemSnapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the snapshots of a Pure Storage FlashBlade file system.",
+		Attributes: map[string]schema.Attribute{
+			"source":    schema.StringAttribute{Description: "The name of the file system whose snapshots are listed.", Required: true},
+			"filter":    schema.StringAttribute{Description: "An API filter expression, e.g. `suffix='daily*'`.", Optional: true},
+			"sort":      schema.StringAttribute{Description: "The attribute to sort by, e.g. `created` or `created-` for descending order.", Optional: true},
+			"destroyed": schema.BoolAttribute{Description: "If set, only lists snapshots that are (or are not) destroyed.", Optional: true},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "The matching snapshots.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":             schema.StringAttribute{Computed: true},
+						"name":           schema.StringAttribute{Computed: true},
+						"suffix":         schema.StringAttribute{Computed: true},
+						"policy_name":    schema.StringAttribute{Computed: true},
+						"created":        schema.Int64Attribute{Computed: true},
+						"destroyed":      schema.BoolAttribute{Computed: true},
+						"time_remaining": schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// --- READ ---
+func (d *fileSystemSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state fileSystemSnapshotsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only snapshots owned by the source are wanted, not snapshots that happen to share its name.
+	filter := client.FilterEquals("source.name", state.Source.ValueString())
+	if !state.Filter.IsNull() {
+		filter = fmt.Sprintf("%s and (%s)", filter, state.Filter.ValueString())
+	}
+	params := &fb.GetApi217FileSystemSnapshotsParams{
+		NamesOrOwnerNames: &[]string{state.Source.ValueString()},
+		Filter:            &filter,
+		Destroyed:         state.Destroyed.ValueBoolPointer(),
+	}
+	if !state.Sort.IsNull() {
+		params.Sort = &[]string{state.Sort.ValueString()}
+	}
+
+	snapshots, err := d.client.ListFileSystemSnapshots(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading File System Snapshots", fmt.Sprintf("Could not list snapshots of file system %s: %s", state.Source.ValueString(), err.Error()))
+		return
+	}
+
+	items := make([]attr.Value, 0, len(snapshots))
+	for _, snap := range snapshots {
+		policyName := types.StringNull()
+		if snap.Policy != nil {
+			policyName = types.StringPointerValue(snap.Policy.Name)
+		}
+		items = append(items, types.ObjectValueMust(fileSystemSnapshotAttributeTypes, map[string]attr.Value{
+			"id":             types.StringPointerValue(snap.Id),
+			"name":           types.StringPointerValue(snap.Name),
+			"suffix":         types.StringPointerValue(snap.Suffix),
+			"policy_name":    policyName,
+			"created":        types.Int64PointerValue(snap.Created),
+			"destroyed":      types.BoolPointerValue(snap.Destroyed),
+			"time_remaining": types.Int64PointerValue(snap.TimeRemaining),
+		}))
+	}
+	state.Snapshots = types.ListValueMust(types.ObjectType{AttrTypes: fileSystemSnapshotAttributeTypes}, items)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- CONFIGURE ---
+func (d *fileSystemSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = c
+}
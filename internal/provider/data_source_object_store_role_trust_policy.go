@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &objectStoreRoleTrustPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectStoreRoleTrustPolicyDataSource{}
+)
+
+func NewObjectStoreRoleTrustPolicyDataSource() datasource.DataSource {
+	return &objectStoreRoleTrustPolicyDataSource{}
+}
+
+type objectStoreRoleTrustPolicyDataSource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreRoleTrustPolicyDataSourceModel struct {
+	Role   types.String `tfsdk:"role"`
+	Policy types.String `tfsdk:"policy"`
+}
+
+func (d *objectStoreRoleTrustPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_role_trust_policy"
+}
+
+// --- SCHEMA ---
+func (d *objectStoreRoleTrustPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders the effective trust policy of a Pure Storage FlashBlade object store role as an IAM JSON document.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{Description: "The name of the role, in the form `<account>/<role>`.", Required: true},
+			"policy": schema.StringAttribute{
+				Description: "The trust policy as a JSON document. The document is re-encoded in a canonical form, so it can be compared against `jsonencode()` output.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// --- READ ---
+func (d *objectStoreRoleTrustPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state objectStoreRoleTrustPolicyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, err := d.client.DownloadTrustPolicy(ctx, state.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Trust Policy", fmt.Sprintf("Could not download trust policy of role %s: %s", state.Role.ValueString(), err.Error()))
+		return
+	}
+
+	// Round-trip the document so key order and whitespace don't depend on the array's encoder.
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		resp.Diagnostics.AddError("Error Reading Trust Policy", fmt.Sprintf("The array returned a trust policy that is not valid JSON: %s", err.Error()))
+		return
+	}
+	normalized, err := json.Marshal(doc)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Trust Policy", "Could not encode trust policy: "+err.Error())
+		return
+	}
+
+	state.Policy = types.StringValue(string(normalized))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- CONFIGURE ---
+func (d *objectStoreRoleTrustPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = c
+}
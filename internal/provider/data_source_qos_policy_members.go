@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &qosPolicyMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &qosPolicyMembersDataSource{}
+)
+
+var qosPolicyMemberAttributeTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"resource_type": types.StringType,
+}
+
+func NewQosPolicyMembersDataSource() datasource.DataSource {
+	return &qosPolicyMembersDataSource{}
+}
+
+type qosPolicyMembersDataSource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type qosPolicyMembersDataSourceModel struct {
+	Policy  types.String `tfsdk:"policy"`
+	Members types.List   `tfsdk:"members"`
+}
+
+func (d *qosPolicyMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qos_policy_members"
+}
+
+// --- SCHEMA ---
+func (d *qosPolicyMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the file systems and buckets a Pure Storage FlashBlade QoS policy is applied to.",
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{Description: "The name of the QoS policy.", Required: true},
+			"members": schema.ListNestedAttribute{
+				Description: "The members of the policy.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":            schema.StringAttribute{Computed: true},
+						"name":          schema.StringAttribute{Computed: true},
+						"resource_type": schema.StringAttribute{Description: "The type of the member, e.g. `file-systems` or `buckets`.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// --- READ ---
+func (d *qosPolicyMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state qosPolicyMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.ListQosPolicyMembers(ctx, state.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading QoS Policy Members", fmt.Sprintf("Could not list the members of QoS policy %s: %s", state.Policy.ValueString(), err.Error()))
+		return
+	}
+
+	items := make([]attr.Value, 0, len(members))
+	for _, m := range members {
+		if m.Member == nil {
+			continue
+		}
+		items = append(items, types.ObjectValueMust(qosPolicyMemberAttributeTypes, map[string]attr.Value{
+			"id":            types.StringPointerValue(m.Member.Id),
+			"name":          types.StringPointerValue(m.Member.Name),
+			"resource_type": types.StringPointerValue(m.Member.ResourceType),
+		}))
+	}
+	state.Members = types.ListValueMust(types.ObjectType{AttrTypes: qosPolicyMemberAttributeTypes}, items)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- CONFIGURE ---
+func (d *qosPolicyMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = c
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &quotaUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &quotaUsageDataSource{}
+)
+
+var quotaUserUsageAttributeTypes = map[string]attr.Type{
+	"uid":                       types.Int64Type,
+	"name":                      types.StringType,
+	"usage":                     types.Int64Type,
+	"quota":                     types.Int64Type,
+	"file_system_default_quota": types.Int64Type,
+}
+
+var quotaGroupUsageAttributeTypes = map[string]attr.Type{
+	"gid":                       types.Int64Type,
+	"name":                      types.StringType,
+	"usage":                     types.Int64Type,
+	"quota":                     types.Int64Type,
+	"file_system_default_quota": types.Int64Type,
+}
+
+func NewQuotaUsageDataSource() datasource.DataSource {
+	return &quotaUsageDataSource{}
+}
+
+type quotaUsageDataSource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type quotaUsageDataSourceModel struct {
+	FileSystem types.String `tfsdk:"file_system"`
+	Filter     types.String `tfsdk:"filter"`
+	Sort       types.String `tfsdk:"sort"`
+	Users      types.List   `tfsdk:"users"`
+	Groups     types.List   `tfsdk:"groups"`
+}
+
+func (d *quotaUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_usage"
+}
+
+// --- SCHEMA ---
+func (d *quotaUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	usageAttributes := func(idName, idDescription string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			idName:                      schema.Int64Attribute{Description: idDescription, Computed: true},
+			"name":                      schema.StringAttribute{Computed: true},
+			"usage":                     schema.Int64Attribute{Description: "The space used on the file system, in bytes.", Computed: true},
+			"quota":                     schema.Int64Attribute{Description: "The explicit quota, in bytes, if one is set.", Computed: true},
+			"file_system_default_quota": schema.Int64Attribute{Description: "The default quota of the file system, in bytes.", Computed: true},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Lists the space used by each user and group of a Pure Storage FlashBlade file system, e.g. to alert on heavy users.",
+		Attributes: map[string]schema.Attribute{
+			"file_system": schema.StringAttribute{Description: "The name of the file system whose usage is listed.", Required: true},
+			"filter":      schema.StringAttribute{Description: "An API filter expression applied to both lists, e.g. `usage>1099511627776`.", Optional: true},
+			"sort":        schema.StringAttribute{Description: "The attribute to sort by, e.g. `usage-` for the heaviest users first.", Optional: true},
+			"users": schema.ListNestedAttribute{
+				Description:  "The usage of each user.",
+				Computed:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: usageAttributes("uid", "The user ID.")},
+			},
+			"groups": schema.ListNestedAttribute{
+				Description:  "The usage of each group.",
+				Computed:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: usageAttributes("gid", "The group ID.")},
+			},
+		},
+	}
+}
+
+// --- READ ---
+func (d *quotaUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state quotaUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileSystem := state.FileSystem.ValueString()
+	var sort *[]string
+	if !state.Sort.IsNull() {
+		sort = &[]string{state.Sort.ValueString()}
+	}
+
+	users, err := d.client.ListUserUsage(ctx, &fb.GetApi217UsageUsersParams{
+		FileSystemNames: &[]string{fileSystem},
+		Filter:          state.Filter.ValueStringPointer(),
+		Sort:            sort,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Quota Usage", fmt.Sprintf("Could not list the user usage of file system %s: %s", fileSystem, err.Error()))
+		return
+	}
+	groups, err := d.client.ListGroupUsage(ctx, &fb.GetApi217UsageGroupsParams{
+		FileSystemNames: &[]string{fileSystem},
+		Filter:          state.Filter.ValueStringPointer(),
+		Sort:            sort,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Quota Usage", fmt.Sprintf("Could not list the group usage of file system %s: %s", fileSystem, err.Error()))
+		return
+	}
+
+	userItems := make([]attr.Value, 0, len(users))
+	for _, u := range users {
+		uid, name := types.Int64Null(), types.StringNull()
+		if u.User != nil {
+			uid = types.Int64PointerValue(u.User.Id)
+			name = types.StringPointerValue(u.User.Name)
+		}
+		userItems = append(userItems, types.ObjectValueMust(quotaUserUsageAttributeTypes, map[string]attr.Value{
+			"uid":                       uid,
+			"name":                      name,
+			"usage":                     types.Int64PointerValue(u.Usage),
+			"quota":                     types.Int64PointerValue(u.Quota),
+			"file_system_default_quota": types.Int64PointerValue(u.FileSystemDefaultQuota),
+		}))
+	}
+	groupItems := make([]attr.Value, 0, len(groups))
+	for _, g := range groups {
+		gid, name := types.Int64Null(), types.StringNull()
+		if g.Group != nil {
+			gid = types.Int64PointerValue(g.Group.Id)
+			name = types.StringPointerValue(g.Group.Name)
+		}
+		groupItems = append(groupItems, types.ObjectValueMust(quotaGroupUsageAttributeTypes, map[string]attr.Value{
+			"gid":                       gid,
+			"name":                      name,
+			"usage":                     types.Int64PointerValue(g.Usage),
+			"quota":                     types.Int64PointerValue(g.Quota),
+			"file_system_default_quota": types.Int64PointerValue(g.FileSystemDefaultQuota),
+		}))
+	}
+	state.Users = types.ListValueMust(types.ObjectType{AttrTypes: quotaUserUsageAttributeTypes}, userItems)
+	state.Groups = types.ListValueMust(types.ObjectType{AttrTypes: quotaGroupUsageAttributeTypes}, groupItems)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- CONFIGURE ---
+func (d *quotaUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = c
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &arrayConnectionKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &arrayConnectionKeyEphemeralResource{}
+)
+
+func NewArrayConnectionKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &arrayConnectionKeyEphemeralResource{}
+}
+
+type arrayConnectionKeyEphemeralResource struct {
+	client *client.Client
+}
+
+type arrayConnectionKeyEphemeralModel struct {
+	ConnectionKey types.String `tfsdk:"connection_key"`
+	Created       types.Int64  `tfsdk:"created"`
+	Expires       types.Int64  `tfsdk:"expires"`
+}
+
+func (e *arrayConnectionKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_array_connection_key"
+}
+
+func (e *arrayConnectionKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a connection key on a Pure Storage FlashBlade, which a remote FlashBlade uses to connect to it with `flashblade_array_connection`. " +
+			"Keys expire shortly after they are generated, so they are never written to the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"connection_key": schema.StringAttribute{
+				Description: "The connection key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created": schema.Int64Attribute{Description: "Creation timestamp of the key.", Computed: true},
+			"expires": schema.Int64Attribute{Description: "Expiration timestamp of the key.", Computed: true},
+		},
+	}
+}
+
+func (e *arrayConnectionKeyEphemeralResource) Open(ctx context.Context, _ ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	key, err := e.client.CreateArrayConnectionKey(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Array Connection Key", "Could not create array connection key: "+err.Error())
+		return
+	}
+
+	data := arrayConnectionKeyEphemeralModel{
+		ConnectionKey: types.StringPointerValue(key.ConnectionKey),
+		Created:       types.Int64PointerValue(key.Created),
+		Expires:       types.Int64PointerValue(key.Expires),
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *arrayConnectionKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	e.client = c
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+)
+
+// intPointerValue converts the *int fields used by some FlashBlade models into a types.Int64.
+func intPointerValue(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+// int32PointerValue converts the *int32 fields used by the network models into a types.Int64.
+func int32PointerValue(v *int32) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+// quotaLimitString renders a quota for the Post/Patch models, which take the limit as a string.
+// A null value becomes an empty string, which the API treats as "unlimited".
+func quotaLimitString(v types.Int64) *string {
+	s := ""
+	if !v.IsNull() && !v.IsUnknown() {
+		s = strconv.FormatInt(v.ValueInt64(), 10)
+	}
+	return &s
+}
+
+// stringSetValue converts an optional list of strings from the API into a set. Missing and
+// empty lists both become null, so an unset attribute doesn't drift against an empty response.
+func stringSetValue(v *[]string) types.Set {
+	if v == nil || len(*v) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elems := make([]attr.Value, 0, len(*v))
+	for _, s := range *v {
+		elems = append(elems, types.StringValue(s))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// stringsFromSet returns the elements of a string set, or nil if the set is null or unknown.
+func stringsFromSet(ctx context.Context, v types.Set) (*[]string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+	var out []string
+	diags := v.ElementsAs(ctx, &out, false)
+	return &out, diags
+}
+
+// stringListValue is stringSetValue for lists whose order matters, such as name or NTP servers.
+func stringListValue(v *[]string) types.List {
+	if v == nil || len(*v) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elems := make([]attr.Value, 0, len(*v))
+	for _, s := range *v {
+		elems = append(elems, types.StringValue(s))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// stringsFromList returns the elements of a string list, or nil if the list is null or unknown.
+func stringsFromList(ctx context.Context, v types.List) (*[]string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+	var out []string
+	diags := v.ElementsAs(ctx, &out, false)
+	return &out, diags
+}
+
+// splitLastSlash splits an import ID of the form "<parent>/<child>" on its last slash, since
+// parent names such as "<account>/<policy>" may contain slashes themselves.
+func splitLastSlash(id string) (string, string, bool) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", "", false
+	}
+	return id[:i], id[i+1:], true
+}
+
+// knownStringPointer returns nil for null and unknown values, so Optional+Computed attributes the
+// user left unset are omitted from requests and the array keeps its default.
+func knownStringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+// knownBoolPointer is the types.Bool counterpart of knownStringPointer.
+func knownBoolPointer(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueBoolPointer()
+}
+
+// knownInt64Pointer is the types.Int64 counterpart of knownStringPointer.
+func knownInt64Pointer(v types.Int64) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueInt64Pointer()
+}
+
+// knownInt32Pointer is knownInt64Pointer for the *int32 fields of the network models.
+func knownInt32Pointer(v types.Int64) *int32 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int32(v.ValueInt64())
+	return &i
+}
+
+// policyRuleRef identifies a rule of an ordered policy, such as an NFS export or SMB client policy.
+type policyRuleRef struct {
+	ID   *string
+	Name *string
+}
+
+// rulePlacement is where a rule of an ordered policy must go to end up at a given index.
+type rulePlacement struct {
+	// Before is the name of the rule to place the rule in front of, or nil if it belongs at the end of the policy.
+	Before *string
+	// Last is the last of the other rules, or nil if there are none. The API can only place a rule in front of
+	// another one, so an existing rule is moved to the end by placing it in front of Last and then moving Last
+	// in front of it.
+	Last *policyRuleRef
+}
+
+// placeRule works out where a rule must go to end up at the given index. rules must be sorted by index.
+// The rule being positioned, identified by id, is left out so that moving a rule further down lands on
+// the requested index. An index past the end of the policy is rejected, since the rule could not end up there.
+func placeRule(rules []policyRuleRef, id string, index int64) (rulePlacement, error) {
+	var others []policyRuleRef
+	for _, rule := range rules {
+		if rule.ID == nil || *rule.ID != id {
+			others = append(others, rule)
+		}
+	}
+	if index < 1 || index > int64(len(others))+1 {
+		return rulePlacement{}, fmt.Errorf("index %d is out of range, the policy has %d other rules, so the index must be between 1 and %d", index, len(others), len(others)+1)
+	}
+	var placement rulePlacement
+	if len(others) > 0 {
+		placement.Last = &others[len(others)-1]
+	}
+	if index <= int64(len(others)) {
+		placement.Before = others[index-1].Name
+	}
+	return placement, nil
+}
+
+// quotaResourceModel holds the attributes user and group quotas have in common. Each quota resource embeds it
+// next to its own ID and name attributes, which address the user or group the quota applies to.
+type quotaResourceModel struct {
+	FileSystem             types.String `tfsdk:"file_system"`
+	Quota                  types.Int64  `tfsdk:"quota"`
+	Usage                  types.Int64  `tfsdk:"usage"`
+	FileSystemDefaultQuota types.Int64  `tfsdk:"file_system_default_quota"`
+}
+
+// mapQuotaToModel maps the attributes user and group quotas have in common.
+func mapQuotaToModel(fileSystem *fb.FixedReference, quota, usage, fileSystemDefaultQuota *int64, model *quotaResourceModel) {
+	model.Quota = types.Int64PointerValue(quota)
+	model.Usage = types.Int64PointerValue(usage)
+	model.FileSystemDefaultQuota = types.Int64PointerValue(fileSystemDefaultQuota)
+	if fileSystem != nil {
+		model.FileSystem = types.StringPointerValue(fileSystem.Name)
+	}
+}
+
+// quotaKey returns the ID or name a user or group quota is addressed by, preferring the ID. It fails
+// when neither is known rather than addressing ID 0, which is root's quota.
+func quotaKey(id types.Int64, name types.String) (*int32, *string, error) {
+	if v := knownInt32Pointer(id); v != nil {
+		return v, nil, nil
+	}
+	if v := knownStringPointer(name); v != nil {
+		return nil, v, nil
+	}
+	return nil, nil, fmt.Errorf("neither the ID nor the name the quota applies to is known")
+}
+
+// pollInterval is how often waitFor re-checks an object that is transitioning on the array.
+const pollInterval = 5 * time.Second
+
+// waitFor calls check until it reports done, returns an error, or the timeout expires. check
+// returns a short description of the current state, which is included in the timeout error.
+func waitFor(ctx context.Context, timeout time.Duration, check func() (bool, string, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		done, current, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s, last observed state: %s", timeout, current)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// describeTestResult describes what an array connectivity test checked and, if it failed, why.
+func describeTestResult(result fb.TestResult) (string, string) {
+	what := types.StringPointerValue(result.TestType).ValueString()
+	if result.Destination != nil {
+		what = fmt.Sprintf("%s to %s", what, *result.Destination)
+	}
+	details := types.StringPointerValue(result.ResultDetails).ValueString()
+	if details == "" {
+		details = types.StringPointerValue(result.Description).ValueString()
+	}
+	return strings.TrimSpace(what), details
+}
+
+// testResultDiagnostics reports every failed check of an array connectivity test as its own
+// error, so that each one shows up separately in the apply output.
+func testResultDiagnostics(summary string, results []fb.TestResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, result := range results {
+		if result.Success == nil || *result.Success {
+			continue
+		}
+		what, details := describeTestResult(result)
+		if result.ComponentName != nil {
+			what = fmt.Sprintf("%s (%s)", what, *result.ComponentName)
+		}
+		diags.AddError(summary, fmt.Sprintf("Check %s failed: %s", what, details))
+	}
+	return diags
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +17,10 @@ import (
 	"terraform-provider-flashblade/internal/client"
 )
 
-var _ provider.Provider = &flashbladeProvider{}
+var (
+	_ provider.Provider                       = &flashbladeProvider{}
+	_ provider.ProviderWithEphemeralResources = &flashbladeProvider{}
+)
 
 type flashbladeProvider struct{}
 
@@ -95,15 +99,87 @@ func (p *flashbladeProvider) Configure(ctx context.Context, req provider.Configu
 
 	resp.ResourceData = fbClient
 	resp.DataSourceData = fbClient
+	resp.EphemeralResourceData = fbClient
 	tflog.Info(ctx, "Configured FlashBlade client", map[string]any{"success": true})
 }
 
 func (p *flashbladeProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewFileSystemResource, // This registers our file system resource
+		NewBucketResource,
+		NewObjectStoreAccountResource,
+		NewObjectStoreUserResource,
+		NewObjectStoreAccessKeyResource,
+		NewObjectStoreAccessPolicyResource,
+		NewObjectStoreAccessPolicyRuleResource,
+		NewObjectStoreAccessPolicyUserAttachmentResource,
+		NewObjectStoreAccessPolicyRoleAttachmentResource,
+		NewObjectStoreRoleResource,
+		NewObjectStoreRoleTrustPolicyRuleResource,
+		NewNfsExportPolicyResource,
+		NewNfsExportPolicyRuleResource,
+		NewSmbSharePolicyResource,
+		NewSmbSharePolicyRuleResource,
+		NewSmbClientPolicyResource,
+		NewSmbClientPolicyRuleResource,
+		NewSnapshotPolicyResource,
+		NewFileSystemPolicyAttachmentResource,
+		NewFileSystemSnapshotResource,
+		NewArrayConnectionResource,
+		NewTargetResource,
+		NewFileSystemReplicaLinkResource,
+		NewObjectStoreRemoteCredentialsResource,
+		NewBucketReplicaLinkResource,
+		NewQosPolicyResource,
+		NewUserQuotaResource,
+		NewGroupQuotaResource,
+		NewQuotaSettingsResource,
+		NewWormDataPolicyResource,
+		NewWormDataPolicyAttachmentResource,
+		NewLegalHoldResource,
+		NewLegalHoldHeldEntityResource,
+		NewFileSystemAuditPolicyResource,
+		NewFileSystemAuditPolicyAttachmentResource,
+		NewSubnetResource,
+		NewNetworkInterfaceResource,
+		NewLinkAggregationGroupResource,
+		NewDnsResource,
+		NewArraySettingsResource,
+		NewSyslogServerResource,
+		NewSyslogSettingsResource,
+		NewSnmpManagerResource,
+		NewSnmpAgentResource,
+		NewSmtpServerResource,
+		NewAlertWatcherResource,
+		NewActiveDirectoryResource,
+		NewDirectoryServiceResource,
+		NewDirectoryServiceRoleResource,
+		NewAdminResource,
+		NewAdminApiTokenResource,
+		NewKeytabResource,
+		NewKmipResource,
+		NewRapidDataLockingResource,
+		NewCertificateResource,
+		NewCertificateGroupResource,
+		NewTlsPolicyResource,
+		NewTlsPolicyAttachmentResource,
+		NewSsoSaml2IdpResource,
+		NewSsoOidcIdpResource,
 	}
 }
 
 func (p *flashbladeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewObjectStoreRoleTrustPolicyDataSource,
+		NewFileSystemSnapshotsDataSource,
+		NewQosPolicyMembersDataSource,
+		NewQuotaUsageDataSource,
+		NewCertificateUsesDataSource,
+	}
+}
+
+func (p *flashbladeProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewArrayConnectionKeyEphemeralResource,
+	}
 }
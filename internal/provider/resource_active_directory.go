@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &activeDirectoryResource{}
+	_ resource.ResourceWithConfigure   = &activeDirectoryResource{}
+	_ resource.ResourceWithImportState = &activeDirectoryResource{}
+)
+
+func NewActiveDirectoryResource() resource.Resource {
+	return &activeDirectoryResource{}
+}
+
+type activeDirectoryResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type activeDirectoryResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Domain                types.String `tfsdk:"domain"`
+	ComputerName          types.String `tfsdk:"computer_name"`
+	JoinOu                types.String `tfsdk:"join_ou"`
+	UserWO                types.String `tfsdk:"user_wo"`
+	PasswordWO            types.String `tfsdk:"password_wo"`
+	JoinExistingAccount   types.Bool   `tfsdk:"join_existing_account"`
+	DirectoryServers      types.List   `tfsdk:"directory_servers"`
+	KerberosServers       types.List   `tfsdk:"kerberos_servers"`
+	GlobalCatalogServers  types.List   `tfsdk:"global_catalog_servers"`
+	EncryptionTypes       types.Set    `tfsdk:"encryption_types"`
+	ServicePrincipalNames types.Set    `tfsdk:"service_principal_names"`
+	LocalOnly             types.Bool   `tfsdk:"local_only"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *activeDirectoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_active_directory"
+}
+
+// --- SCHEMA ---
+func (r *activeDirectoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Joins a Pure Storage FlashBlade to an Active Directory domain, which SMB file systems need for authentication.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the Active Directory configuration.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"domain": schema.StringAttribute{
+				Description:   "The Active Directory domain to join, e.g. `corp.example.com`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"computer_name": schema.StringAttribute{
+				Description:   "The name of the computer account of the array in the domain. Defaults to the array name.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplaceIfConfigured()},
+			},
+			"join_ou": schema.StringAttribute{
+				Description:   "The distinguished name of the organizational unit the computer account is placed in, e.g. `OU=Storage,DC=corp,DC=example,DC=com`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_wo": schema.StringAttribute{
+				Description: "The domain user that joins the array to the domain. Only used when joining. This value is write-only and is never stored in the state.",
+				Required:    true,
+				WriteOnly:   true,
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "The password of `user_wo`. Only used when joining. This value is write-only and is never stored in the state.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"join_existing_account": schema.BoolAttribute{
+				Description:   "If true, the array joins a computer account that already exists in the domain instead of creating one.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"directory_servers": schema.ListAttribute{
+				Description:   "The domain controllers used for LDAP queries, in order of preference. If not set, they are discovered through DNS.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"kerberos_servers": schema.ListAttribute{
+				Description:   "The key distribution centers used for Kerberos, in order of preference. If not set, they are discovered through DNS.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"global_catalog_servers": schema.ListAttribute{
+				Description:   "The global catalog servers used to look up users from other domains of the forest, in order of preference.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"encryption_types": schema.SetAttribute{
+				Description:   "The Kerberos encryption types the computer account supports, e.g. `aes256-cts-hmac-sha1-96`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"service_principal_names": schema.SetAttribute{
+				Description:   "The service principal names registered for the computer account.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"local_only": schema.BoolAttribute{
+				Description: "If true, destroying this resource only removes the configuration from the array and leaves the computer account in the domain.",
+				Optional:    true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, the connection to the domain is tested after the array joins or the configuration is updated. Each failed check is reported as an error.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Map FB API Active Directory configuration to resource model. The join credentials are
+// deliberately left alone, because they are write-only.
+func mapActiveDirectoryToModel(ad *fb.ActiveDirectory, model *activeDirectoryResourceModel) {
+	model.ID = types.StringPointerValue(ad.Id)
+	model.Name = types.StringPointerValue(ad.Name)
+	model.Domain = types.StringPointerValue(ad.Domain)
+	model.ComputerName = types.StringPointerValue(ad.ComputerName)
+	model.JoinOu = types.StringPointerValue(ad.JoinOu)
+	model.DirectoryServers = stringListValue(ad.DirectoryServers)
+	model.KerberosServers = stringListValue(ad.KerberosServers)
+	model.GlobalCatalogServers = stringListValue(ad.GlobalCatalogServers)
+	model.EncryptionTypes = stringSetValue(ad.EncryptionTypes)
+	model.ServicePrincipalNames = stringSetValue(ad.ServicePrincipalNames)
+}
+
+// activeDirectoryPatchFromPlan builds the settings that can be changed after joining, which are
+// sent on create as well.
+func activeDirectoryPatchFromPlan(ctx context.Context, plan activeDirectoryResourceModel) (fb.ActiveDirectoryPatch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	patch := fb.ActiveDirectoryPatch{JoinOu: knownStringPointer(plan.JoinOu)}
+
+	var d diag.Diagnostics
+	patch.DirectoryServers, d = stringsFromList(ctx, plan.DirectoryServers)
+	diags.Append(d...)
+	patch.KerberosServers, d = stringsFromList(ctx, plan.KerberosServers)
+	diags.Append(d...)
+	patch.GlobalCatalogServers, d = stringsFromList(ctx, plan.GlobalCatalogServers)
+	diags.Append(d...)
+	patch.EncryptionTypes, d = stringsFromSet(ctx, plan.EncryptionTypes)
+	diags.Append(d...)
+	patch.ServicePrincipalNames, d = stringsFromSet(ctx, plan.ServicePrincipalNames)
+	diags.Append(d...)
+	return patch, diags
+}
+
+// test checks the connection to the domain if test_on_apply is set.
+func (r *activeDirectoryResource) test(ctx context.Context, model activeDirectoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestActiveDirectory(ctx, name)
+	if err != nil {
+		diags.AddError("Error Testing Active Directory", fmt.Sprintf("Could not test Active Directory configuration %s: %s", name, err.Error()))
+		return diags
+	}
+	return testResultDiagnostics("Active Directory Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *activeDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan activeDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user, password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_wo"), &user)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	patch, diags := activeDirectoryPatchFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adToCreate := fb.ActiveDirectoryPost{
+		Domain:                plan.Domain.ValueString(),
+		User:                  user.ValueString(),
+		Password:              password.ValueString(),
+		ComputerName:          knownStringPointer(plan.ComputerName),
+		JoinOu:                patch.JoinOu,
+		DirectoryServers:      patch.DirectoryServers,
+		KerberosServers:       patch.KerberosServers,
+		GlobalCatalogServers:  patch.GlobalCatalogServers,
+		EncryptionTypes:       patch.EncryptionTypes,
+		ServicePrincipalNames: patch.ServicePrincipalNames,
+	}
+	createdAD, err := r.client.CreateActiveDirectory(ctx, plan.Name.ValueString(), plan.JoinExistingAccount.ValueBool(), &adToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Active Directory", fmt.Sprintf("Could not join domain %s: %s", plan.Domain.ValueString(), err.Error()))
+		return
+	}
+
+	mapActiveDirectoryToModel(createdAD, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *activeDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state activeDirectoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ad, err := r.client.GetActiveDirectoryByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Active Directory", fmt.Sprintf("Could not read Active Directory configuration %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if ad == nil {
+		tflog.Warn(ctx, "Active Directory configuration not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapActiveDirectoryToModel(ad, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// The join credentials are only needed to join, so changing them alone doesn't do anything.
+func (r *activeDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan activeDirectoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adToUpdate, diags := activeDirectoryPatchFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatedAD, err := r.client.UpdateActiveDirectory(ctx, plan.Name.ValueString(), &adToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Active Directory", fmt.Sprintf("Could not update Active Directory configuration: %s", err.Error()))
+		return
+	}
+
+	mapActiveDirectoryToModel(updatedAD, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *activeDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state activeDirectoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteActiveDirectory(ctx, state.Name.ValueString(), state.LocalOnly.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Active Directory", fmt.Sprintf("Could not delete Active Directory configuration %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *activeDirectoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// An imported configuration is already joined, so the join credentials in the configuration
+// aren't used until it is replaced.
+func (r *activeDirectoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
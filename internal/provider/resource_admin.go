@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &adminResource{}
+	_ resource.ResourceWithConfigure      = &adminResource{}
+	_ resource.ResourceWithImportState    = &adminResource{}
+	_ resource.ResourceWithValidateConfig = &adminResource{}
+)
+
+func NewAdminResource() resource.Resource {
+	return &adminResource{}
+}
+
+type adminResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type adminResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	PasswordWO       types.String `tfsdk:"password_wo"`
+	PasswordVersion  types.Int64  `tfsdk:"password_wo_version"`
+	Role             types.String `tfsdk:"role"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	Locked           types.Bool   `tfsdk:"locked"`
+	IsLocal          types.Bool   `tfsdk:"is_local"`
+	LockoutRemaining types.Int64  `tfsdk:"lockout_remaining"`
+}
+
+func (r *adminResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin"
+}
+
+// --- SCHEMA ---
+func (r *adminResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a local administrator account of a Pure Storage FlashBlade.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The login name of the administrator.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "The password of the administrator. This value is write-only and is never stored in the state.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Change this value to set the password to the current `password_wo` again, e.g. after rotating it.",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description:   "The role of the administrator, e.g. `array_admin`, `storage_admin`, `ops_admin` or `readonly`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"public_key": schema.StringAttribute{
+				Description:   "The SSH public key the administrator can log in with.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"locked": schema.BoolAttribute{
+				Description:   "Whether the account is locked after too many failed logins. It can only be set to false, which unlocks the account.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"is_local":          schema.BoolAttribute{Description: "True for accounts that are defined on the array rather than in a directory service.", Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}},
+			"lockout_remaining": schema.Int64Attribute{Description: "How long the account stays locked, in milliseconds.", Computed: true},
+		},
+	}
+}
+
+func (r *adminResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config adminResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Locked.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("locked"), "Invalid Admin Lock", "Accounts are only locked by failed logins. `locked` can only be set to false, to unlock the account.")
+	}
+}
+
+// Map FB API admin to resource model. The password is deliberately left alone, because it is
+// write-only.
+func mapAdminToModel(a *fb.Admin, model *adminResourceModel) {
+	model.ID = types.StringPointerValue(a.Id)
+	model.Name = types.StringPointerValue(a.Name)
+	model.PublicKey = types.StringPointerValue(a.PublicKey)
+	model.Locked = types.BoolPointerValue(a.Locked)
+	model.IsLocal = types.BoolPointerValue(a.IsLocal)
+	model.LockoutRemaining = types.Int64PointerValue(a.LockoutRemaining)
+	model.Role = types.StringNull()
+	if a.Role != nil {
+		model.Role = types.StringPointerValue(a.Role.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *adminResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan adminResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adminToCreate := fb.AdminPost{
+		Password:  knownStringPointer(password),
+		PublicKey: knownStringPointer(plan.PublicKey),
+	}
+	if role := knownStringPointer(plan.Role); role != nil {
+		adminToCreate.Role = &fb.ReferenceWritable{Name: role}
+	}
+	createdAdmin, err := r.client.CreateAdmin(ctx, plan.Name.ValueString(), &adminToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Admin", "Could not create admin: "+err.Error())
+		return
+	}
+
+	mapAdminToModel(createdAdmin, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *adminResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state adminResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	admin, err := r.client.GetAdminByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Admin", fmt.Sprintf("Could not read admin %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if admin == nil {
+		tflog.Warn(ctx, "Admin not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapAdminToModel(admin, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Write-only values never show up in a plan diff, so the password is only sent again when
+// password_wo_version changes.
+func (r *adminResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state adminResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only changed fields are sent, since the array refuses role changes for built-in admins such as pureuser.
+	adminToUpdate := fb.AdminPatch{}
+	if !plan.PublicKey.Equal(state.PublicKey) {
+		adminToUpdate.PublicKey = knownStringPointer(plan.PublicKey)
+	}
+	if role := knownStringPointer(plan.Role); role != nil && !plan.Role.Equal(state.Role) {
+		adminToUpdate.Role = &fb.ReferenceWritable{Name: role}
+	}
+	if !plan.Locked.ValueBool() && state.Locked.ValueBool() {
+		adminToUpdate.Locked = knownBoolPointer(plan.Locked)
+	}
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		adminToUpdate.Password = knownStringPointer(password)
+	}
+	updatedAdmin, err := r.client.UpdateAdmin(ctx, plan.Name.ValueString(), &adminToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Admin", fmt.Sprintf("Could not update admin: %s", err.Error()))
+		return
+	}
+
+	mapAdminToModel(updatedAdmin, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *adminResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state adminResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAdmin(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Admin", fmt.Sprintf("Could not delete admin %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *adminResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Imported admins keep their password until password_wo_version is changed.
+func (r *adminResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
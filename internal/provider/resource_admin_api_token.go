@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &adminApiTokenResource{}
+	_ resource.ResourceWithConfigure      = &adminApiTokenResource{}
+	_ resource.ResourceWithValidateConfig = &adminApiTokenResource{}
+)
+
+func NewAdminApiTokenResource() resource.Resource {
+	return &adminApiTokenResource{}
+}
+
+type adminApiTokenResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type adminApiTokenResourceModel struct {
+	Admin     types.String `tfsdk:"admin"`
+	Timeout   types.String `tfsdk:"timeout"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+	ExpiresAt types.Int64  `tfsdk:"expires_at"`
+}
+
+func (r *adminApiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_api_token"
+}
+
+// --- SCHEMA ---
+func (r *adminApiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a REST API token for a Pure Storage FlashBlade administrator. An administrator has at most one token, so this replaces any token the administrator already had. " +
+			"The token is only returned when it is issued and is kept in the state as a sensitive value. Once it expires, the next plan issues a new one.",
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.StringAttribute{
+				Description:   "The name of the administrator to issue the token for.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"timeout": schema.StringAttribute{
+				Description:   "How long the token is valid, as a Go duration such as `720h`. If not set, the token doesn't expire.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"token": schema.StringAttribute{
+				Description:   "The API token.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.Int64Attribute{Description: "When the token was issued, in milliseconds since the UNIX epoch.", Computed: true},
+			"expires_at": schema.Int64Attribute{Description: "When the token expires, in milliseconds since the UNIX epoch.", Computed: true},
+		},
+	}
+}
+
+func (r *adminApiTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config adminApiTokenResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Timeout.IsNull() || config.Timeout.IsUnknown() {
+		return
+	}
+	if timeout, err := time.ParseDuration(config.Timeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid API Token Timeout",
+			fmt.Sprintf("`timeout` must be a positive duration such as `720h`, got %q.", config.Timeout.ValueString()))
+	}
+}
+
+// Map FB API admin API token to resource model. The token itself is only set when it is present,
+// since it is only returned when the token is issued.
+func mapAdminApiTokenToModel(t *fb.AdminApiToken, model *adminApiTokenResourceModel) {
+	if t.Admin != nil {
+		model.Admin = types.StringPointerValue(t.Admin.Name)
+	}
+	if t.ApiToken == nil {
+		return
+	}
+	if t.ApiToken.Token != nil {
+		model.Token = types.StringPointerValue(t.ApiToken.Token)
+	}
+	model.CreatedAt = types.Int64PointerValue(t.ApiToken.CreatedAt)
+	model.ExpiresAt = types.Int64PointerValue(t.ApiToken.ExpiresAt)
+}
+
+// --- CREATE ---
+func (r *adminApiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan adminApiTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeout *int64
+	if !plan.Timeout.IsNull() {
+		parsed, err := time.ParseDuration(plan.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid API Token Timeout", err.Error())
+			return
+		}
+		ms := parsed.Milliseconds()
+		timeout = &ms
+	}
+
+	createdToken, err := r.client.CreateAdminApiToken(ctx, plan.Admin.ValueString(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Admin API Token", fmt.Sprintf("Could not issue an API token for admin %s: %s", plan.Admin.ValueString(), err.Error()))
+		return
+	}
+
+	mapAdminApiTokenToModel(createdToken, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+// A token that was revoked, replaced or has expired is removed from the state, so that the next
+// apply issues a new one.
+func (r *adminApiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state adminApiTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.GetAdminApiToken(ctx, state.Admin.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Admin API Token", fmt.Sprintf("Could not read the API token of admin %s: %s", state.Admin.ValueString(), err.Error()))
+		return
+	}
+	if token == nil || !types.Int64PointerValue(token.ApiToken.CreatedAt).Equal(state.CreatedAt) {
+		tflog.Warn(ctx, "Admin API token not found or replaced, removing from state.", map[string]interface{}{"admin": state.Admin.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if expiresAt := token.ApiToken.ExpiresAt; expiresAt != nil && *expiresAt <= time.Now().UnixMilli() {
+		tflog.Warn(ctx, "Admin API token has expired, removing from state.", map[string]interface{}{"admin": state.Admin.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapAdminApiTokenToModel(token, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Every attribute that can be configured forces a new token, so there is nothing to update.
+func (r *adminApiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan adminApiTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *adminApiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state adminApiTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAdminApiToken(ctx, state.Admin.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Admin API Token", fmt.Sprintf("Could not revoke the API token of admin %s: %s", state.Admin.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *adminApiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &alertWatcherResource{}
+	_ resource.ResourceWithConfigure   = &alertWatcherResource{}
+	_ resource.ResourceWithImportState = &alertWatcherResource{}
+)
+
+func NewAlertWatcherResource() resource.Resource {
+	return &alertWatcherResource{}
+}
+
+type alertWatcherResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type alertWatcherResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	MinimumNotificationSeverity types.String `tfsdk:"minimum_notification_severity"`
+	TestOnApply                 types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *alertWatcherResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_watcher"
+}
+
+// --- SCHEMA ---
+func (r *alertWatcherResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an email address a Pure Storage FlashBlade sends alerts to. Email is delivered through the `flashblade_smtp_server` relay.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The email address of the watcher.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, alerts are emailed to the watcher.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"minimum_notification_severity": schema.StringAttribute{
+				Description:   "The lowest severity of the alerts emailed to the watcher, `info`, `warning` or `critical`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, a test alert is sent to the watcher after it is created or updated, and the apply fails if it can't be delivered.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Map FB API alert watcher to resource model
+func mapAlertWatcherToModel(w *fb.AlertWatcher, model *alertWatcherResourceModel) {
+	model.ID = types.StringPointerValue(w.Id)
+	model.Name = types.StringPointerValue(w.Name)
+	model.Enabled = types.BoolPointerValue(w.Enabled)
+	model.MinimumNotificationSeverity = types.StringPointerValue(w.MinimumNotificationSeverity)
+}
+
+// test sends a test alert to the watcher if test_on_apply is set.
+func (r *alertWatcherResource) test(ctx context.Context, model alertWatcherResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestAlertWatchers(ctx, name)
+	if err != nil {
+		diags.AddError("Error Testing Alert Watcher", fmt.Sprintf("Could not test alert watcher %s: %s", name, err.Error()))
+		return diags
+	}
+	return testResultDiagnostics("Alert Watcher Test Failed", results)
+}
+
+// --- CREATE ---
+// Watchers are always created enabled, so a watcher that starts out disabled is patched right
+// after it is created.
+func (r *alertWatcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertWatcherResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	watcherToCreate := fb.AlertWatcherPost{MinimumNotificationSeverity: knownStringPointer(plan.MinimumNotificationSeverity)}
+	createdWatcher, err := r.client.CreateAlertWatcher(ctx, name, &watcherToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Alert Watcher", "Could not create alert watcher: "+err.Error())
+		return
+	}
+
+	if enabled := knownBoolPointer(plan.Enabled); enabled != nil && !*enabled {
+		createdWatcher, err = r.client.UpdateAlertWatcher(ctx, name, &fb.AlertWatcher{Enabled: enabled})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Creating Alert Watcher", fmt.Sprintf("Alert watcher %s was created but could not be disabled: %s", name, err.Error()))
+			return
+		}
+	}
+
+	mapAlertWatcherToModel(createdWatcher, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *alertWatcherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertWatcherResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	watcher, err := r.client.GetAlertWatcherByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Alert Watcher", fmt.Sprintf("Could not read alert watcher %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if watcher == nil {
+		tflog.Warn(ctx, "Alert watcher not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapAlertWatcherToModel(watcher, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *alertWatcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan alertWatcherResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	watcherToUpdate := fb.AlertWatcher{
+		Enabled:                     knownBoolPointer(plan.Enabled),
+		MinimumNotificationSeverity: knownStringPointer(plan.MinimumNotificationSeverity),
+	}
+	updatedWatcher, err := r.client.UpdateAlertWatcher(ctx, plan.Name.ValueString(), &watcherToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Alert Watcher", fmt.Sprintf("Could not update alert watcher: %s", err.Error()))
+		return
+	}
+
+	mapAlertWatcherToModel(updatedWatcher, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *alertWatcherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertWatcherResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAlertWatcher(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Alert Watcher", fmt.Sprintf("Could not delete alert watcher %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *alertWatcherResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *alertWatcherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
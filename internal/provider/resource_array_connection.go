@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &arrayConnectionResource{}
+	_ resource.ResourceWithConfigure   = &arrayConnectionResource{}
+	_ resource.ResourceWithImportState = &arrayConnectionResource{}
+)
+
+var throttleAttributeTypes = map[string]attr.Type{
+	"default_limit": types.Int64Type,
+	"window_limit":  types.Int64Type,
+	"window_start":  types.Int64Type,
+	"window_end":    types.Int64Type,
+}
+
+func NewArrayConnectionResource() resource.Resource {
+	return &arrayConnectionResource{}
+}
+
+type arrayConnectionResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type arrayConnectionResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ManagementAddress    types.String `tfsdk:"management_address"`
+	ConnectionKeyWO      types.String `tfsdk:"connection_key_wo"`
+	ReplicationAddresses types.Set    `tfsdk:"replication_addresses"`
+	Encrypted            types.Bool   `tfsdk:"encrypted"`
+	Throttle             types.Object `tfsdk:"throttle"`
+	RemoteName           types.String `tfsdk:"remote_name"`
+	Status               types.String `tfsdk:"status"`
+	Type                 types.String `tfsdk:"type"`
+	Os                   types.String `tfsdk:"os"`
+	Version              types.String `tfsdk:"version"`
+}
+
+type throttleModel struct {
+	DefaultLimit types.Int64 `tfsdk:"default_limit"`
+	WindowLimit  types.Int64 `tfsdk:"window_limit"`
+	WindowStart  types.Int64 `tfsdk:"window_start"`
+	WindowEnd    types.Int64 `tfsdk:"window_end"`
+}
+
+func (r *arrayConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_array_connection"
+}
+
+// --- SCHEMA ---
+func (r *arrayConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a replication connection from this Pure Storage FlashBlade to a remote FlashBlade. " +
+			"The remote array issues the connection key, e.g. through the `flashblade_array_connection_key` ephemeral resource of a provider configured for it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"management_address": schema.StringAttribute{
+				Description:   "The management address of the remote array.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"connection_key_wo": schema.StringAttribute{
+				Description: "The connection key issued by the remote array. Only used when the connection is created. " +
+					"This value is write-only and is never stored in the state.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"replication_addresses": schema.SetAttribute{
+				Description:   "The replication addresses of the remote array. Defaults to every replication address of the remote array.",
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown(), setplanmodifier.RequiresReplace()},
+			},
+			"encrypted": schema.BoolAttribute{
+				Description:   "If true, replicated data is only sent over a TLS encrypted connection.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"throttle": schema.SingleNestedAttribute{
+				Description:   "Bandwidth throttling of outbound replication traffic.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"default_limit": schema.Int64Attribute{Description: "The maximum outbound bandwidth in bytes per second outside the window.", Optional: true, Computed: true},
+					"window_limit":  schema.Int64Attribute{Description: "The maximum outbound bandwidth in bytes per second during the window.", Optional: true, Computed: true},
+					"window_start":  schema.Int64Attribute{Description: "The start of the window in milliseconds since midnight, on the hour (e.g. `18000000` for 5:00 AM).", Optional: true, Computed: true},
+					"window_end":    schema.Int64Attribute{Description: "The end of the window in milliseconds since midnight, on the hour (e.g. `28800000` for 8:00 AM).", Optional: true, Computed: true},
+				},
+			},
+			"remote_name": schema.StringAttribute{Description: "The name of the remote array.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"status":      schema.StringAttribute{Description: "The status of the connection, e.g. `connected` or `partially_connected`.", Computed: true},
+			"type":        schema.StringAttribute{Description: "The type of the connection.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"os":          schema.StringAttribute{Description: "The operating system of the remote array.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"version":     schema.StringAttribute{Description: "The version of the remote array.", Computed: true},
+		},
+	}
+}
+
+// Map FB API array connection to resource model
+func mapArrayConnectionToModel(conn *fb.ArrayConnection, model *arrayConnectionResourceModel) {
+	model.ID = types.StringPointerValue(conn.Id)
+	model.ManagementAddress = types.StringPointerValue(conn.ManagementAddress)
+	model.ReplicationAddresses = stringSetValue(conn.ReplicationAddresses)
+	model.Encrypted = types.BoolPointerValue(conn.Encrypted)
+	model.Status = types.StringPointerValue(conn.Status)
+	model.Type = types.StringPointerValue(conn.Type)
+	model.Os = types.StringPointerValue(conn.Os)
+	model.Version = types.StringPointerValue(conn.Version)
+	if conn.Remote != nil {
+		model.RemoteName = types.StringPointerValue(conn.Remote.Name)
+	}
+
+	throttle := map[string]attr.Value{
+		"default_limit": types.Int64Null(),
+		"window_limit":  types.Int64Null(),
+		"window_start":  types.Int64Null(),
+		"window_end":    types.Int64Null(),
+	}
+	if conn.Throttle != nil {
+		throttle["default_limit"] = types.Int64PointerValue(conn.Throttle.DefaultLimit)
+		throttle["window_limit"] = types.Int64PointerValue(conn.Throttle.WindowLimit)
+		if conn.Throttle.Window != nil {
+			throttle["window_start"] = types.Int64PointerValue(conn.Throttle.Window.Start)
+			throttle["window_end"] = types.Int64PointerValue(conn.Throttle.Window.End)
+		}
+	}
+	model.Throttle = basetypes.NewObjectValueMust(throttleAttributeTypes, throttle)
+}
+
+func throttleRequest(ctx context.Context, obj types.Object) (*fb.Throttle, error) {
+	var data throttleModel
+	if diags := obj.As(ctx, &data, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return nil, fmt.Errorf("invalid throttle")
+	}
+	throttle := &fb.Throttle{
+		DefaultLimit: knownInt64Pointer(data.DefaultLimit),
+		WindowLimit:  knownInt64Pointer(data.WindowLimit),
+	}
+	start, end := knownInt64Pointer(data.WindowStart), knownInt64Pointer(data.WindowEnd)
+	if start != nil || end != nil {
+		throttle.Window = &fb.TimeWindow{Start: start, End: end}
+	}
+	return throttle, nil
+}
+
+// --- CREATE ---
+func (r *arrayConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan arrayConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	var connectionKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("connection_key_wo"), &connectionKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if connectionKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("connection_key_wo"), "Missing Connection Key",
+			"A connection key issued by the remote array is required to create the connection. Generate one on the remote array, e.g. with the `flashblade_array_connection_key` ephemeral resource.")
+		return
+	}
+
+	addresses, diags := stringsFromSet(ctx, plan.ReplicationAddresses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connToCreate := fb.ArrayConnectionPost{
+		ManagementAddress:    plan.ManagementAddress.ValueStringPointer(),
+		ConnectionKey:        connectionKey.ValueStringPointer(),
+		ReplicationAddresses: addresses,
+		Encrypted:            knownBoolPointer(plan.Encrypted),
+	}
+	if !plan.Throttle.IsUnknown() && !plan.Throttle.IsNull() {
+		throttle, err := throttleRequest(ctx, plan.Throttle)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("throttle"), "Invalid Throttle", err.Error())
+			return
+		}
+		connToCreate.Throttle = throttle
+	}
+
+	createdConn, err := r.client.CreateArrayConnection(ctx, &connToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Array Connection", fmt.Sprintf("Could not connect to array %s: %s", plan.ManagementAddress.ValueString(), err.Error()))
+		return
+	}
+
+	mapArrayConnectionToModel(createdConn, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *arrayConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state arrayConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := r.client.GetArrayConnectionByID(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Array Connection", fmt.Sprintf("Could not read array connection %s: %s", state.ID.ValueString(), err.Error()))
+		return
+	}
+	if conn == nil {
+		tflog.Warn(ctx, "Array connection not found, removing from state.", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapArrayConnectionToModel(conn, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *arrayConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state arrayConnectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connToUpdate := fb.ArrayConnection{}
+	isPatchNeeded := false
+
+	if !plan.Encrypted.IsUnknown() && !plan.Encrypted.Equal(state.Encrypted) {
+		isPatchNeeded = true
+		connToUpdate.Encrypted = plan.Encrypted.ValueBoolPointer()
+	}
+	if !plan.Throttle.IsNull() && !plan.Throttle.Equal(state.Throttle) {
+		throttle, err := throttleRequest(ctx, plan.Throttle)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("throttle"), "Invalid Throttle", err.Error())
+			return
+		}
+		isPatchNeeded = true
+		connToUpdate.Throttle = throttle
+	}
+
+	var updatedConn *fb.ArrayConnection
+	var err error
+	if isPatchNeeded {
+		updatedConn, err = r.client.UpdateArrayConnection(ctx, state.ID.ValueString(), &connToUpdate)
+	} else {
+		// The status is never carried over from the state, so refresh it even without changes.
+		tflog.Debug(ctx, "No changes detected for array connection, skipping API call.")
+		updatedConn, err = r.client.GetArrayConnectionByID(ctx, state.ID.ValueString())
+		if err == nil && updatedConn == nil {
+			err = fmt.Errorf("array connection %s no longer exists", state.ID.ValueString())
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Array Connection", fmt.Sprintf("Could not update array connection: %s", err.Error()))
+		return
+	}
+
+	mapArrayConnectionToModel(updatedConn, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *arrayConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state arrayConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteArrayConnection(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Array Connection", fmt.Sprintf("Could not delete connection to array %s: %s", state.RemoteName.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *arrayConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Connections are imported by the name of the remote array, which is easier to find than the ID.
+func (r *arrayConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	conn, err := r.client.GetArrayConnectionByRemoteName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing Array Connection", fmt.Sprintf("Could not look up the connection to array %s: %s", req.ID, err.Error()))
+		return
+	}
+	if conn == nil || conn.Id == nil {
+		resp.Diagnostics.AddError("Array Connection Not Found", fmt.Sprintf("There is no connection to an array named %s.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), *conn.Id)...)
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &arraySettingsResource{}
+	_ resource.ResourceWithConfigure   = &arraySettingsResource{}
+	_ resource.ResourceWithImportState = &arraySettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &arraySettingsResource{}
+)
+
+var eulaAttributeTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"title":   types.StringType,
+	"company": types.StringType,
+}
+
+func NewArraySettingsResource() resource.Resource {
+	return &arraySettingsResource{}
+}
+
+type arraySettingsResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type arraySettingsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	NtpServers   types.List   `tfsdk:"ntp_servers"`
+	TimeZone     types.String `tfsdk:"time_zone"`
+	IdleTimeout  types.Int64  `tfsdk:"idle_timeout"`
+	Banner       types.String `tfsdk:"banner"`
+	Eula         types.Object `tfsdk:"eula"`
+	EulaAccepted types.Int64  `tfsdk:"eula_accepted"`
+	Os           types.String `tfsdk:"os"`
+	Version      types.String `tfsdk:"version"`
+}
+
+type eulaModel struct {
+	Name    types.String `tfsdk:"name"`
+	Title   types.String `tfsdk:"title"`
+	Company types.String `tfsdk:"company"`
+}
+
+func (r *arraySettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_array_settings"
+}
+
+// --- SCHEMA ---
+func (r *arraySettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the array-wide settings of a Pure Storage FlashBlade, such as its name, NTP servers and time zone. " +
+			"The settings always exist, so creating this resource adopts them and destroying it only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the array.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ntp_servers": schema.ListAttribute{
+				Description:   "The NTP servers the array synchronizes its clock with, as host names or IP addresses.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"time_zone": schema.StringAttribute{
+				Description:   "The time zone of the array, e.g. `America/Los_Angeles`. It must be one of the time zones the array supports.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"idle_timeout": schema.Int64Attribute{
+				Description:   "The idle time after which management sessions are logged out, in milliseconds. 0 disables the timeout.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"banner": schema.StringAttribute{
+				Description:   "The login banner shown to administrators.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"eula": schema.SingleNestedAttribute{
+				Description: "Accepts the end user license agreement on behalf of the given signatory. Changing the signatory accepts it again.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name":    schema.StringAttribute{Description: "The name of the person accepting the agreement.", Required: true},
+					"title":   schema.StringAttribute{Description: "The job title of the person accepting the agreement.", Required: true},
+					"company": schema.StringAttribute{Description: "The company the agreement is accepted for.", Required: true},
+				},
+			},
+			"eula_accepted": schema.Int64Attribute{Description: "When the end user license agreement was accepted, in milliseconds since the UNIX epoch.", Computed: true},
+			"os":            schema.StringAttribute{Description: "The operating system of the array.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"version":       schema.StringAttribute{Description: "The version of the operating system.", Computed: true},
+		},
+	}
+}
+
+// ModifyPlan checks time_zone against the time zones the array supports, since an unsupported
+// value is otherwise only rejected halfway through the apply.
+func (r *arraySettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var timeZone, currentTimeZone types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("time_zone"), &timeZone)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("time_zone"), &currentTimeZone)...)
+	}
+	if resp.Diagnostics.HasError() || timeZone.IsNull() || timeZone.IsUnknown() || timeZone.Equal(currentTimeZone) {
+		return
+	}
+
+	supported, err := r.client.ListSupportedTimeZones(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Supported Time Zones", "Could not list the time zones the array supports: "+err.Error())
+		return
+	}
+	for _, tz := range supported {
+		if tz == timeZone.ValueString() {
+			return
+		}
+	}
+	detail := fmt.Sprintf("The array does not support time zone %q.", timeZone.ValueString())
+	for _, tz := range supported {
+		if strings.EqualFold(tz, timeZone.ValueString()) {
+			detail += fmt.Sprintf(" Did you mean %q?", tz)
+			break
+		}
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("time_zone"), "Unsupported Time Zone", detail+" Time zones are IANA names such as `America/Los_Angeles` or `Europe/Berlin`.")
+}
+
+// Map FB API array to resource model
+func mapArrayToModel(a *fb.Array, model *arraySettingsResourceModel) {
+	model.ID = types.StringPointerValue(a.Id)
+	model.Name = types.StringPointerValue(a.Name)
+	model.NtpServers = stringListValue(a.NtpServers)
+	model.TimeZone = types.StringPointerValue(a.TimeZone)
+	model.IdleTimeout = int32PointerValue(a.IdleTimeout)
+	model.Banner = types.StringPointerValue(a.Banner)
+	model.Os = types.StringPointerValue(a.Os)
+	model.Version = types.StringPointerValue(a.Version)
+}
+
+// Map FB API EULA to resource model. The signatory is only read back when it is configured, so
+// that an agreement accepted outside of Terraform doesn't show up as drift.
+func mapEulaToModel(e *fb.Eula, model *arraySettingsResourceModel) {
+	model.EulaAccepted = types.Int64Null()
+	if e == nil || e.Signature == nil {
+		return
+	}
+	model.EulaAccepted = types.Int64PointerValue(e.Signature.Accepted)
+	if !model.Eula.IsNull() {
+		model.Eula = types.ObjectValueMust(eulaAttributeTypes, map[string]attr.Value{
+			"name":    types.StringPointerValue(e.Signature.Name),
+			"title":   types.StringPointerValue(e.Signature.Title),
+			"company": types.StringPointerValue(e.Signature.Company),
+		})
+	}
+}
+
+// apply patches the array with whatever the plan sets, and accepts the EULA if its signatory
+// changed.
+func (r *arraySettingsResource) apply(ctx context.Context, plan *arraySettingsResourceModel, priorEula types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ntpServers, d := stringsFromList(ctx, plan.NtpServers)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	arrayToUpdate := fb.Array{
+		Name:        knownStringPointer(plan.Name),
+		NtpServers:  ntpServers,
+		TimeZone:    knownStringPointer(plan.TimeZone),
+		IdleTimeout: knownInt32Pointer(plan.IdleTimeout),
+		Banner:      knownStringPointer(plan.Banner),
+	}
+	var array *fb.Array
+	var err error
+	if arrayToUpdate.Name == nil && arrayToUpdate.NtpServers == nil && arrayToUpdate.TimeZone == nil && arrayToUpdate.IdleTimeout == nil && arrayToUpdate.Banner == nil {
+		array, err = r.client.GetArray(ctx)
+	} else {
+		array, err = r.client.UpdateArray(ctx, &arrayToUpdate)
+	}
+	if err != nil {
+		diags.AddError("Error Updating Array Settings", "Could not update the array settings: "+err.Error())
+		return diags
+	}
+	mapArrayToModel(array, plan)
+
+	var eula *fb.Eula
+	if !plan.Eula.IsNull() && !plan.Eula.Equal(priorEula) {
+		var signatory eulaModel
+		diags.Append(plan.Eula.As(ctx, &signatory, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+		eula, err = r.client.AcceptArrayEula(ctx, &fb.EulaSignature{
+			Name:    signatory.Name.ValueStringPointer(),
+			Title:   signatory.Title.ValueStringPointer(),
+			Company: signatory.Company.ValueStringPointer(),
+		})
+		if err != nil {
+			diags.AddError("Error Accepting EULA", "Could not accept the end user license agreement: "+err.Error())
+			return diags
+		}
+	} else {
+		eula, err = r.client.GetArrayEula(ctx)
+		if err != nil {
+			diags.AddError("Error Reading EULA", "Could not read the end user license agreement: "+err.Error())
+			return diags
+		}
+	}
+	mapEulaToModel(eula, plan)
+	return diags
+}
+
+// --- CREATE ---
+func (r *arraySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan arraySettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, types.ObjectNull(eulaAttributeTypes))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *arraySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state arraySettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	array, err := r.client.GetArray(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Array Settings", "Could not read the array settings: "+err.Error())
+		return
+	}
+	eula, err := r.client.GetArrayEula(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading EULA", "Could not read the end user license agreement: "+err.Error())
+		return
+	}
+
+	mapArrayToModel(array, &state)
+	mapEulaToModel(eula, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *arraySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state arraySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, state.Eula)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The settings can't be deleted, so they are left as they are and only dropped from the state.
+func (r *arraySettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Array settings cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *arraySettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one set of array settings, so any import ID will do.
+func (r *arraySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &bucketResource{}
+	_ resource.ResourceWithConfigure   = &bucketResource{}
+	_ resource.ResourceWithImportState = &bucketResource{}
+)
+
+var objectLockConfigAttributeTypes = map[string]attr.Type{
+	"enabled":                types.BoolType,
+	"default_retention":      types.Int64Type,
+	"default_retention_mode": types.StringType,
+	"freeze_locked_objects":  types.BoolType,
+}
+
+func NewBucketResource() resource.Resource {
+	return &bucketResource{}
+}
+
+type bucketResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type bucketResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Account          types.String `tfsdk:"account"`
+	Versioning       types.String `tfsdk:"versioning"`
+	QuotaLimit       types.Int64  `tfsdk:"quota_limit"`
+	HardLimitEnabled types.Bool   `tfsdk:"hard_limit_enabled"`
+	BucketType       types.String `tfsdk:"bucket_type"`
+	RetentionLock    types.String `tfsdk:"retention_lock"`
+	ObjectLockConfig types.Object `tfsdk:"object_lock_config"`
+	ObjectCount      types.Int64  `tfsdk:"object_count"`
+	Created          types.Int64  `tfsdk:"created"`
+	Destroyed        types.Bool   `tfsdk:"destroyed"`
+	TimeRemaining    types.Int64  `tfsdk:"time_remaining"`
+}
+
+type objectLockConfigModel struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	DefaultRetention     types.Int64  `tfsdk:"default_retention"`
+	DefaultRetentionMode types.String `tfsdk:"default_retention_mode"`
+	FreezeLockedObjects  types.Bool   `tfsdk:"freeze_locked_objects"`
+}
+
+func (r *bucketResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+// --- SCHEMA ---
+func (r *bucketResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade S3 bucket.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the bucket.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"account": schema.StringAttribute{
+				Description:   "The name of the object store account the bucket belongs to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"versioning": schema.StringAttribute{
+				Description:   "The versioning state for objects within the bucket. Can be `none`, `enabled` or `suspended`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"quota_limit": schema.Int64Attribute{
+				Description:   "The quota limit applied against the size of the bucket, in bytes. Defaults to the account's `bucket_defaults`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"hard_limit_enabled": schema.BoolAttribute{
+				Description:   "If set to true, the bucket's `quota_limit` is used as a hard limit quota.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"bucket_type": schema.StringAttribute{
+				Description:   "The bucket type. Can be `classic` or `multi-site-writable`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"retention_lock": schema.StringAttribute{
+				Description:   "Can be `unlocked` or `ratcheted`. Once `ratcheted`, only Pure Technical Services can unlock it.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"object_lock_config": schema.SingleNestedAttribute{
+				Description: "Object lock configuration. Object lock can only be enabled when the bucket is created.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"enabled":                schema.BoolAttribute{Description: "If true, object lock is enabled for the bucket.", Optional: true, Computed: true},
+					"default_retention":      schema.Int64Attribute{Description: "The retention period, in milliseconds, applied to new objects.", Optional: true, Computed: true},
+					"default_retention_mode": schema.StringAttribute{Description: "The retention mode applied to new objects. Can be `compliance` or `governance`.", Optional: true, Computed: true},
+					"freeze_locked_objects":  schema.BoolAttribute{Description: "If true, locked objects are read-only and cannot be overwritten.", Optional: true, Computed: true},
+				},
+			},
+			"object_count":   schema.Int64Attribute{Description: "The count of objects within the bucket.", Computed: true},
+			"created":        schema.Int64Attribute{Description: "Creation timestamp of the bucket.", Computed: true},
+			"destroyed":      schema.BoolAttribute{Description: "Is the bucket destroyed?", Computed: true},
+			"time_remaining": schema.Int64Attribute{Description: "Time in milliseconds before the bucket is eradicated.", Computed: true},
+		},
+	}
+}
+
+// Map FB API bucket to resource model
+func mapBucketToModel(b *fb.Bucket, model *bucketResourceModel) {
+	model.ID = types.StringPointerValue(b.Id)
+	model.Name = types.StringPointerValue(b.Name)
+	model.Versioning = types.StringPointerValue(b.Versioning)
+	model.QuotaLimit = intPointerValue(b.QuotaLimit)
+	model.HardLimitEnabled = types.BoolPointerValue(b.HardLimitEnabled)
+	model.BucketType = types.StringPointerValue(b.BucketType)
+	model.RetentionLock = types.StringPointerValue(b.RetentionLock)
+	model.ObjectCount = types.Int64PointerValue(b.ObjectCount)
+	model.Created = types.Int64PointerValue(b.Created)
+	model.Destroyed = types.BoolPointerValue(b.Destroyed)
+	model.TimeRemaining = types.Int64PointerValue(b.TimeRemaining)
+
+	if b.Account != nil {
+		model.Account = types.StringPointerValue(b.Account.Name)
+	}
+
+	if b.ObjectLockConfig != nil {
+		model.ObjectLockConfig = basetypes.NewObjectValueMust(objectLockConfigAttributeTypes, map[string]attr.Value{
+			"enabled":                types.BoolPointerValue(b.ObjectLockConfig.Enabled),
+			"default_retention":      types.Int64PointerValue(b.ObjectLockConfig.DefaultRetention),
+			"default_retention_mode": types.StringPointerValue(b.ObjectLockConfig.DefaultRetentionMode),
+			"freeze_locked_objects":  types.BoolPointerValue(b.ObjectLockConfig.FreezeLockedObjects),
+		})
+	} else {
+		model.ObjectLockConfig = types.ObjectNull(objectLockConfigAttributeTypes)
+	}
+}
+
+// objectLockConfigRequest converts the planned object lock configuration into its request body.
+// Unknown values are left unset so the array keeps its defaults.
+func objectLockConfigRequest(ctx context.Context, obj types.Object) (*fb.ObjectLockConfigRequestBody, error) {
+	var data objectLockConfigModel
+	if diags := obj.As(ctx, &data, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return nil, fmt.Errorf("invalid object_lock_config")
+	}
+	body := &fb.ObjectLockConfigRequestBody{
+		Enabled:              knownBoolPointer(data.Enabled),
+		DefaultRetentionMode: knownStringPointer(data.DefaultRetentionMode),
+		FreezeLockedObjects:  knownBoolPointer(data.FreezeLockedObjects),
+	}
+	if !data.DefaultRetention.IsNull() && !data.DefaultRetention.IsUnknown() {
+		retention := strconv.FormatInt(data.DefaultRetention.ValueInt64(), 10)
+		body.DefaultRetention = &retention
+	}
+	return body, nil
+}
+
+// --- CREATE ---
+func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketToCreate := fb.BucketPost{
+		Account:          &fb.ReferenceWritable{Name: plan.Account.ValueStringPointer()},
+		HardLimitEnabled: knownBoolPointer(plan.HardLimitEnabled),
+		BucketType:       knownStringPointer(plan.BucketType),
+		RetentionLock:    knownStringPointer(plan.RetentionLock),
+	}
+	if !plan.QuotaLimit.IsUnknown() && !plan.QuotaLimit.IsNull() {
+		bucketToCreate.QuotaLimit = quotaLimitString(plan.QuotaLimit)
+	}
+	if !plan.ObjectLockConfig.IsUnknown() && !plan.ObjectLockConfig.IsNull() {
+		lockConfig, err := objectLockConfigRequest(ctx, plan.ObjectLockConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("object_lock_config"), "Invalid Object Lock Configuration", err.Error())
+			return
+		}
+		bucketToCreate.ObjectLockConfig = lockConfig
+	}
+
+	bucketName := plan.Name.ValueString()
+	createdBucket, err := r.client.CreateBucket(ctx, bucketName, &bucketToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Bucket", "Could not create bucket: "+err.Error())
+		return
+	}
+
+	// Versioning is not part of BucketPost, so it has to be applied with a follow-up patch.
+	if !plan.Versioning.IsUnknown() && !plan.Versioning.IsNull() && plan.Versioning.ValueString() != types.StringPointerValue(createdBucket.Versioning).ValueString() {
+		createdBucket, err = r.client.UpdateBucket(ctx, bucketName, &fb.BucketPatch{Versioning: knownStringPointer(plan.Versioning)})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Setting Bucket Versioning", fmt.Sprintf("Bucket %s was created but versioning could not be set: %s", bucketName, err.Error()))
+			return
+		}
+	}
+
+	mapBucketToModel(createdBucket, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *bucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := r.client.GetBucketByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Bucket", fmt.Sprintf("Could not read bucket %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if bucket == nil || (bucket.Destroyed != nil && *bucket.Destroyed) {
+		tflog.Warn(ctx, "Bucket not found or destroyed, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapBucketToModel(bucket, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *bucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketToUpdate := fb.BucketPatch{}
+	isPatchNeeded := false
+
+	if !plan.Versioning.Equal(state.Versioning) {
+		isPatchNeeded = true
+		bucketToUpdate.Versioning = knownStringPointer(plan.Versioning)
+	}
+	if !plan.QuotaLimit.Equal(state.QuotaLimit) {
+		isPatchNeeded = true
+		bucketToUpdate.QuotaLimit = quotaLimitString(plan.QuotaLimit)
+	}
+	if !plan.HardLimitEnabled.Equal(state.HardLimitEnabled) {
+		isPatchNeeded = true
+		bucketToUpdate.HardLimitEnabled = knownBoolPointer(plan.HardLimitEnabled)
+	}
+	if !plan.RetentionLock.Equal(state.RetentionLock) {
+		isPatchNeeded = true
+		bucketToUpdate.RetentionLock = knownStringPointer(plan.RetentionLock)
+	}
+	if !plan.ObjectLockConfig.IsUnknown() && !plan.ObjectLockConfig.IsNull() && !plan.ObjectLockConfig.Equal(state.ObjectLockConfig) {
+		lockConfig, err := objectLockConfigRequest(ctx, plan.ObjectLockConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("object_lock_config"), "Invalid Object Lock Configuration", err.Error())
+			return
+		}
+		isPatchNeeded = true
+		bucketToUpdate.ObjectLockConfig = lockConfig
+	}
+
+	if !isPatchNeeded {
+		// Values the array computes may still have changed, so read the bucket instead of patching it.
+		tflog.Debug(ctx, "No changes detected for bucket, reading it instead of updating it.")
+		bucket, err := r.client.GetBucketByName(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Bucket", fmt.Sprintf("Could not read bucket %s: %s", plan.Name.ValueString(), err.Error()))
+			return
+		}
+		if bucket == nil {
+			resp.Diagnostics.AddError("Error Reading Bucket", fmt.Sprintf("Bucket %s no longer exists.", plan.Name.ValueString()))
+			return
+		}
+		mapBucketToModel(bucket, &plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	updatedBucket, err := r.client.UpdateBucket(ctx, plan.Name.ValueString(), &bucketToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Bucket", fmt.Sprintf("Could not update bucket: %s", err.Error()))
+		return
+	}
+
+	mapBucketToModel(updatedBucket, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketName := state.Name.ValueString()
+
+	bucket, err := r.client.GetBucketByName(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Bucket on Delete", fmt.Sprintf("Could not read bucket %s before deletion: %s", bucketName, err.Error()))
+		return
+	}
+	if bucket == nil {
+		tflog.Warn(ctx, "Bucket not found, removing from state.", map[string]interface{}{"name": bucketName})
+		return
+	}
+
+	if bucket.Destroyed == nil || !*bucket.Destroyed {
+		tflog.Debug(ctx, "Step 1: Marking bucket for destruction...", map[string]interface{}{"name": bucketName})
+		shouldDestroy := true
+		_, err = r.client.UpdateBucket(ctx, bucketName, &fb.BucketPatch{Destroyed: &shouldDestroy})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Marking Bucket For Deletion", fmt.Sprintf("Could not mark bucket %s for deletion: %s", bucketName, err.Error()))
+			return
+		}
+	} else {
+		tflog.Debug(ctx, "Bucket is already marked for destruction. Skipping soft delete step.", map[string]interface{}{"name": bucketName})
+	}
+
+	tflog.Debug(ctx, "Step 2: Eradicating the bucket...", map[string]interface{}{"name": bucketName})
+	err = r.client.EradicateBucket(ctx, bucketName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Eradicating Bucket", fmt.Sprintf("Could not eradicate bucket %s: %s", bucketName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *bucketResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *bucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &bucketReplicaLinkResource{}
+	_ resource.ResourceWithConfigure   = &bucketReplicaLinkResource{}
+	_ resource.ResourceWithImportState = &bucketReplicaLinkResource{}
+)
+
+func NewBucketReplicaLinkResource() resource.Resource {
+	return &bucketReplicaLinkResource{}
+}
+
+type bucketReplicaLinkResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type bucketReplicaLinkResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	LocalBucket       types.String `tfsdk:"local_bucket"`
+	RemoteBucket      types.String `tfsdk:"remote_bucket"`
+	RemoteCredentials types.String `tfsdk:"remote_credentials"`
+	Paused            types.Bool   `tfsdk:"paused"`
+	CascadingEnabled  types.Bool   `tfsdk:"cascading_enabled"`
+	Remote            types.String `tfsdk:"remote"`
+	Direction         types.String `tfsdk:"direction"`
+	Status            types.String `tfsdk:"status"`
+	StatusDetails     types.String `tfsdk:"status_details"`
+	Lag               types.Int64  `tfsdk:"lag"`
+	RecoveryPoint     types.Int64  `tfsdk:"recovery_point"`
+}
+
+func (r *bucketReplicaLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_replica_link"
+}
+
+// --- SCHEMA ---
+func (r *bucketReplicaLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the replication of a Pure Storage FlashBlade bucket to a bucket on a remote FlashBlade or S3 target.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"local_bucket": schema.StringAttribute{
+				Description:   "The name of the local bucket to replicate.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"remote_bucket": schema.StringAttribute{
+				Description:   "The name of the bucket on the remote to replicate to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"remote_credentials": schema.StringAttribute{
+				Description:   "The name of the credentials used to access the remote bucket, e.g. the `name` of a `flashblade_object_store_remote_credentials`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"paused": schema.BoolAttribute{
+				Description:   "If true, replication over the link is paused. Pausing and resuming don't recreate the link.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"cascading_enabled": schema.BoolAttribute{
+				Description:   "If true, objects replicated to the local bucket from another array are replicated to the remote bucket as well.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"remote":         schema.StringAttribute{Description: "The name of the remote array or target.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"direction":      schema.StringAttribute{Description: "The direction of replication, `outbound` or `inbound`.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"status":         schema.StringAttribute{Description: "The status of the link, e.g. `replicating`, `paused` or `unhealthy`.", Computed: true},
+			"status_details": schema.StringAttribute{Description: "Details about the status of the link when it is unhealthy.", Computed: true},
+			"lag":            schema.Int64Attribute{Description: "How far the remote bucket is behind the local one, in milliseconds.", Computed: true},
+			"recovery_point": schema.Int64Attribute{Description: "Timestamp before which all object changes are guaranteed to have been replicated.", Computed: true},
+		},
+	}
+}
+
+// Map FB API bucket replica link to resource model
+func mapBucketReplicaLinkToModel(link *fb.BucketReplicaLink, model *bucketReplicaLinkResourceModel) {
+	model.ID = types.StringPointerValue(link.Id)
+	model.Paused = types.BoolPointerValue(link.Paused)
+	model.CascadingEnabled = types.BoolPointerValue(link.CascadingEnabled)
+	model.Direction = types.StringPointerValue(link.Direction)
+	model.Status = types.StringPointerValue(link.Status)
+	model.StatusDetails = types.StringPointerValue(link.StatusDetails)
+	model.Lag = types.Int64PointerValue(link.Lag)
+	model.RecoveryPoint = types.Int64PointerValue(link.RecoveryPoint)
+	if link.LocalBucket != nil {
+		model.LocalBucket = types.StringPointerValue(link.LocalBucket.Name)
+	}
+	if link.RemoteBucket != nil {
+		model.RemoteBucket = types.StringPointerValue(link.RemoteBucket.Name)
+	}
+	if link.RemoteCredentials != nil {
+		model.RemoteCredentials = types.StringPointerValue(link.RemoteCredentials.Name)
+	}
+	if link.Remote != nil {
+		model.Remote = types.StringPointerValue(link.Remote.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *bucketReplicaLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketReplicaLinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkToCreate := fb.BucketReplicaLinkPost{
+		Paused:           knownBoolPointer(plan.Paused),
+		CascadingEnabled: knownBoolPointer(plan.CascadingEnabled),
+	}
+	createdLink, err := r.client.CreateBucketReplicaLink(ctx, plan.LocalBucket.ValueString(), plan.RemoteBucket.ValueString(), plan.RemoteCredentials.ValueString(), &linkToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Bucket Replica Link", "Could not create bucket replica link: "+err.Error())
+		return
+	}
+
+	mapBucketReplicaLinkToModel(createdLink, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *bucketReplicaLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketReplicaLinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := r.client.GetBucketReplicaLinkByID(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Bucket Replica Link", fmt.Sprintf("Could not read bucket replica link %s: %s", state.ID.ValueString(), err.Error()))
+		return
+	}
+	if link == nil {
+		tflog.Warn(ctx, "Bucket replica link not found, removing from state.", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapBucketReplicaLinkToModel(link, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *bucketReplicaLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketReplicaLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkToUpdate := fb.BucketReplicaLink{
+		Paused:           knownBoolPointer(plan.Paused),
+		CascadingEnabled: knownBoolPointer(plan.CascadingEnabled),
+	}
+	updatedLink, err := r.client.UpdateBucketReplicaLink(ctx, state.ID.ValueString(), &linkToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Bucket Replica Link", fmt.Sprintf("Could not update bucket replica link: %s", err.Error()))
+		return
+	}
+
+	mapBucketReplicaLinkToModel(updatedLink, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *bucketReplicaLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketReplicaLinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBucketReplicaLink(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Bucket Replica Link", fmt.Sprintf("Could not delete the replica link of bucket %s to %s: %s", state.LocalBucket.ValueString(), state.RemoteBucket.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *bucketReplicaLinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Replica links are imported by their ID.
+func (r *bucketReplicaLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
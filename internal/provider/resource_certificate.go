@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &certificateResource{}
+	_ resource.ResourceWithConfigure      = &certificateResource{}
+	_ resource.ResourceWithImportState    = &certificateResource{}
+	_ resource.ResourceWithValidateConfig = &certificateResource{}
+	_ resource.ResourceWithModifyPlan     = &certificateResource{}
+)
+
+func NewCertificateResource() resource.Resource {
+	return &certificateResource{}
+}
+
+type certificateResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type certificateResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	CertificateType         types.String `tfsdk:"certificate_type"`
+	Certificate             types.String `tfsdk:"certificate"`
+	IntermediateCertificate types.String `tfsdk:"intermediate_certificate"`
+	PrivateKeyWO            types.String `tfsdk:"private_key_wo"`
+	PassphraseWO            types.String `tfsdk:"passphrase_wo"`
+	CommonName              types.String `tfsdk:"common_name"`
+	Country                 types.String `tfsdk:"country"`
+	State                   types.String `tfsdk:"state"`
+	Locality                types.String `tfsdk:"locality"`
+	Organization            types.String `tfsdk:"organization"`
+	OrganizationalUnit      types.String `tfsdk:"organizational_unit"`
+	Email                   types.String `tfsdk:"email"`
+	KeySize                 types.Int64  `tfsdk:"key_size"`
+	SubjectAlternativeNames types.Set    `tfsdk:"subject_alternative_names"`
+	IssuedBy                types.String `tfsdk:"issued_by"`
+	IssuedTo                types.String `tfsdk:"issued_to"`
+	ValidFrom               types.String `tfsdk:"valid_from"`
+	ValidTo                 types.String `tfsdk:"valid_to"`
+	Status                  types.String `tfsdk:"status"`
+}
+
+func (r *certificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+// --- SCHEMA ---
+func (r *certificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	subject := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description:   description + " Only used to generate the certificate, and forces a new one when changed.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplaceIfConfigured()},
+		}
+	}
+	computed := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Description: description, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a certificate on a Pure Storage FlashBlade. Either import a PEM certificate with `certificate` and `private_key_wo`, " +
+			"or leave `certificate` unset to have the array generate a self-signed one from the subject attributes. " +
+			"Changing `certificate` replaces it in place, so whatever uses it keeps doing so; see `flashblade_certificate_uses`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the certificate.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"certificate_type": schema.StringAttribute{
+				Description:   "`appliance` for a certificate the array presents to its clients, or `external` for the CA certificate of a server the array connects to.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"certificate": schema.StringAttribute{
+				Description:   "The PEM-encoded certificate. Without it, the array generates a self-signed certificate, and this is the certificate it generated.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"intermediate_certificate": schema.StringAttribute{
+				Description:   "The PEM-encoded intermediate certificates of an imported certificate.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"private_key_wo": schema.StringAttribute{
+				Description: "The PEM-encoded private key of an imported certificate. It is sent whenever `certificate` changes. This value is write-only and is never stored in the state.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"passphrase_wo": schema.StringAttribute{
+				Description: "The passphrase of an encrypted `private_key_wo`. This value is write-only and is never stored in the state.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"common_name":         subject("The common name of the certificate, e.g. the FQDN of the array."),
+			"country":             subject("The two-letter country code of the subject."),
+			"state":               subject("The state or province of the subject."),
+			"locality":            subject("The locality, e.g. the city, of the subject."),
+			"organization":        subject("The organization of the subject."),
+			"organizational_unit": subject("The organizational unit of the subject."),
+			"email":               subject("The email address of the subject."),
+			"key_size": schema.Int64Attribute{
+				Description:   "The size of the generated private key, in bits. Only used to generate the certificate, and forces a new one when changed.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplaceIfConfigured()},
+			},
+			"subject_alternative_names": schema.SetAttribute{
+				Description:   "The subject alternative names of the certificate.",
+				ElementType:   types.StringType,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"issued_by":  computed("The issuer of the certificate."),
+			"issued_to":  computed("The subject the certificate is issued to."),
+			"valid_from": computed("The time the certificate becomes valid."),
+			"valid_to":   computed("The time the certificate expires."),
+			"status":     computed("The status of the certificate, e.g. `self-signed` or `imported`."),
+		},
+	}
+}
+
+// ValidateConfig keeps the two ways to get a certificate apart, since the array ignores the subject
+// attributes of an imported certificate and has no key for a generated one.
+func (r *certificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config certificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Certificate.IsUnknown() {
+		return
+	}
+
+	if config.Certificate.IsNull() {
+		for name, v := range map[string]types.String{"intermediate_certificate": config.IntermediateCertificate, "private_key_wo": config.PrivateKeyWO, "passphrase_wo": config.PassphraseWO} {
+			if !v.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Certificate Configuration", fmt.Sprintf("`%s` can only be set together with `certificate`.", name))
+			}
+		}
+		return
+	}
+	subject := map[string]attr.Value{
+		"common_name":         config.CommonName,
+		"country":             config.Country,
+		"state":               config.State,
+		"locality":            config.Locality,
+		"organization":        config.Organization,
+		"organizational_unit": config.OrganizationalUnit,
+		"email":               config.Email,
+		"key_size":            config.KeySize,
+	}
+	for name, v := range subject {
+		if !v.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Certificate Configuration", fmt.Sprintf("`%s` is only used to generate a certificate and cannot be set together with `certificate`.", name))
+		}
+	}
+}
+
+// ModifyPlan marks everything the array derives from the certificate as unknown when the certificate
+// is replaced, instead of carrying the values of the old one over.
+func (r *certificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state certificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || (plan.Certificate.Equal(state.Certificate) && plan.IntermediateCertificate.Equal(state.IntermediateCertificate)) {
+		return
+	}
+
+	var config certificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.IntermediateCertificate.IsNull() {
+		plan.IntermediateCertificate = types.StringUnknown()
+	}
+	plan.CommonName = types.StringUnknown()
+	plan.Country = types.StringUnknown()
+	plan.State = types.StringUnknown()
+	plan.Locality = types.StringUnknown()
+	plan.Organization = types.StringUnknown()
+	plan.OrganizationalUnit = types.StringUnknown()
+	plan.Email = types.StringUnknown()
+	plan.KeySize = types.Int64Unknown()
+	plan.SubjectAlternativeNames = types.SetUnknown(types.StringType)
+	plan.IssuedBy = types.StringUnknown()
+	plan.IssuedTo = types.StringUnknown()
+	plan.ValidFrom = types.StringUnknown()
+	plan.ValidTo = types.StringUnknown()
+	plan.Status = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// pemValue keeps the configured PEM if the array returns it with different surrounding whitespace.
+func pemValue(current types.String, v *string) types.String {
+	if v != nil && !current.IsNull() && !current.IsUnknown() && strings.TrimSpace(current.ValueString()) == strings.TrimSpace(*v) {
+		return current
+	}
+	return types.StringPointerValue(v)
+}
+
+// Map FB API certificate to resource model. The private key and passphrase are deliberately left
+// alone, because they are write-only.
+func mapCertificateToModel(cert *fb.Certificate, model *certificateResourceModel) {
+	model.ID = types.StringPointerValue(cert.Id)
+	model.Name = types.StringPointerValue(cert.Name)
+	model.CertificateType = types.StringPointerValue(cert.CertificateType)
+	model.Certificate = pemValue(model.Certificate, cert.Certificate)
+	model.IntermediateCertificate = pemValue(model.IntermediateCertificate, cert.IntermediateCertificate)
+	model.CommonName = types.StringPointerValue(cert.CommonName)
+	model.Country = types.StringPointerValue(cert.Country)
+	model.State = types.StringPointerValue(cert.State)
+	model.Locality = types.StringPointerValue(cert.Locality)
+	model.Organization = types.StringPointerValue(cert.Organization)
+	model.OrganizationalUnit = types.StringPointerValue(cert.OrganizationalUnit)
+	model.Email = types.StringPointerValue(cert.Email)
+	model.KeySize = int32PointerValue(cert.KeySize)
+	model.SubjectAlternativeNames = stringSetValue(cert.SubjectAlternativeNames)
+	model.IssuedBy = types.StringPointerValue(cert.IssuedBy)
+	model.IssuedTo = types.StringPointerValue(cert.IssuedTo)
+	model.ValidFrom = types.StringPointerValue(cert.ValidFrom)
+	model.ValidTo = types.StringPointerValue(cert.ValidTo)
+	model.Status = types.StringPointerValue(cert.Status)
+}
+
+// configuredKey reads the write-only private key and passphrase, which are only available in the
+// configuration.
+func (r *certificateResource) configuredKey(ctx context.Context, config tfsdk.Config) (types.String, types.String, diag.Diagnostics) {
+	var privateKey, passphrase types.String
+	var diags diag.Diagnostics
+	diags.Append(config.GetAttribute(ctx, path.Root("private_key_wo"), &privateKey)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("passphrase_wo"), &passphrase)...)
+	return privateKey, passphrase, diags
+}
+
+// --- CREATE ---
+func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan certificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, passphrase, diags := r.configuredKey(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certToCreate := fb.CertificatePost{
+		CertificateType:         knownStringPointer(plan.CertificateType),
+		Certificate:             knownStringPointer(plan.Certificate),
+		IntermediateCertificate: knownStringPointer(plan.IntermediateCertificate),
+		PrivateKey:              privateKey.ValueStringPointer(),
+		Passphrase:              passphrase.ValueStringPointer(),
+		CommonName:              knownStringPointer(plan.CommonName),
+		Country:                 knownStringPointer(plan.Country),
+		State:                   knownStringPointer(plan.State),
+		Locality:                knownStringPointer(plan.Locality),
+		Organization:            knownStringPointer(plan.Organization),
+		OrganizationalUnit:      knownStringPointer(plan.OrganizationalUnit),
+		Email:                   knownStringPointer(plan.Email),
+		KeySize:                 knownInt32Pointer(plan.KeySize),
+	}
+	createdCert, err := r.client.CreateCertificate(ctx, plan.Name.ValueString(), &certToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Certificate", "Could not create certificate: "+err.Error())
+		return
+	}
+
+	mapCertificateToModel(createdCert, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state certificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.GetCertificateByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Certificate", fmt.Sprintf("Could not read certificate %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if cert == nil {
+		tflog.Warn(ctx, "Certificate not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapCertificateToModel(cert, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Everything but the certificate itself forces replacement. Replacing the certificate in place keeps
+// it bound to whatever uses it, which is the point of rotating it this way.
+func (r *certificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state certificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Certificate.Equal(state.Certificate) && plan.IntermediateCertificate.Equal(state.IntermediateCertificate) {
+		tflog.Debug(ctx, "No changes detected for certificate, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	privateKey, passphrase, diags := r.configuredKey(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certToUpdate := fb.CertificatePatch{
+		Certificate:             knownStringPointer(plan.Certificate),
+		IntermediateCertificate: knownStringPointer(plan.IntermediateCertificate),
+		PrivateKey:              privateKey.ValueStringPointer(),
+		Passphrase:              passphrase.ValueStringPointer(),
+	}
+	updatedCert, err := r.client.UpdateCertificate(ctx, state.Name.ValueString(), &certToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Certificate", fmt.Sprintf("Could not update certificate: %s", err.Error()))
+		return
+	}
+
+	mapCertificateToModel(updatedCert, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state certificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certName := state.Name.ValueString()
+
+	// The array refuses to delete a certificate that is still in use, with an error that doesn't say
+	// where. Check first, so the user knows what to move to another certificate.
+	uses, err := r.client.ListCertificateUses(ctx, []string{certName})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Certificate Uses", fmt.Sprintf("Could not list the uses of certificate %s before deletion: %s", certName, err.Error()))
+		return
+	}
+	if len(uses) > 0 {
+		names := make([]string, 0, len(uses))
+		for _, u := range uses {
+			names = append(names, describeCertificateUse(u))
+		}
+		resp.Diagnostics.AddError("Certificate Still In Use",
+			fmt.Sprintf("Certificate %s cannot be deleted while it is used by: %s. Move these to another certificate first.", certName, strings.Join(names, ", ")))
+		return
+	}
+
+	err = r.client.DeleteCertificate(ctx, certName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Certificate", fmt.Sprintf("Could not delete certificate %s: %s", certName, err.Error()))
+		return
+	}
+}
+
+// describeCertificateUse names what a certificate is used by, e.g. "management (tls-policies)".
+func describeCertificateUse(u fb.CertificateUse) string {
+	if u.Use == nil {
+		return "unknown"
+	}
+	name := types.StringPointerValue(u.Use.Name).ValueString()
+	if u.Use.Remote != nil && u.Use.Remote.Name != nil {
+		name = *u.Use.Remote.Name + ":" + name
+	}
+	if u.Use.ResourceType != nil {
+		name = fmt.Sprintf("%s (%s)", name, *u.Use.ResourceType)
+	}
+	if u.Group != nil && u.Group.Name != nil {
+		name = fmt.Sprintf("%s via certificate group %s", name, *u.Group.Name)
+	}
+	return name
+}
+
+// --- CONFIGURE ---
+func (r *certificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Imported certificates keep their private key until `certificate` is changed.
+func (r *certificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
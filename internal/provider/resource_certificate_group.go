@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &certificateGroupResource{}
+	_ resource.ResourceWithConfigure   = &certificateGroupResource{}
+	_ resource.ResourceWithImportState = &certificateGroupResource{}
+)
+
+func NewCertificateGroupResource() resource.Resource {
+	return &certificateGroupResource{}
+}
+
+type certificateGroupResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type certificateGroupResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Certificates types.Set    `tfsdk:"certificates"`
+}
+
+func (r *certificateGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_group"
+}
+
+// --- SCHEMA ---
+func (r *certificateGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a group of certificates on a Pure Storage FlashBlade, e.g. the CA certificates used to verify a server via `ca_certificate_group`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the certificate group.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"certificates": schema.SetAttribute{
+				Description:   "The names of the certificates in the group. If not set, the members of the group are not managed.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// readCertificates sets the certificates of the group in the model. An empty group stays an empty
+// set if it was configured as one.
+func (r *certificateGroupResource) readCertificates(ctx context.Context, model *certificateGroupResourceModel) error {
+	names, err := r.client.ListCertificateGroupCertificates(ctx, model.Name.ValueString())
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 && !model.Certificates.IsNull() && !model.Certificates.IsUnknown() && len(model.Certificates.Elements()) == 0 {
+		return nil
+	}
+	model.Certificates = stringSetValue(&names)
+	return nil
+}
+
+// --- CREATE ---
+func (r *certificateGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan certificateGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificates, diags := stringsFromSet(ctx, plan.Certificates)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdGroup, err := r.client.CreateCertificateGroup(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Certificate Group", "Could not create certificate group: "+err.Error())
+		return
+	}
+	plan.ID = types.StringPointerValue(createdGroup.Id)
+
+	if certificates != nil && len(*certificates) > 0 {
+		if err := r.client.AddCertificateGroupCertificates(ctx, plan.Name.ValueString(), *certificates); err != nil {
+			resp.Diagnostics.AddError("Error Adding Certificates", fmt.Sprintf("Could not add certificates to certificate group %s: %s", plan.Name.ValueString(), err.Error()))
+			return
+		}
+	}
+	if err := r.readCertificates(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Error Reading Certificate Group", fmt.Sprintf("Could not read the certificates of certificate group %s: %s", plan.Name.ValueString(), err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *certificateGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state certificateGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetCertificateGroupByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Certificate Group", fmt.Sprintf("Could not read certificate group %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if group == nil {
+		tflog.Warn(ctx, "Certificate group not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringPointerValue(group.Id)
+	state.Name = types.StringPointerValue(group.Name)
+	if err := r.readCertificates(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Error Reading Certificate Group", fmt.Sprintf("Could not read the certificates of certificate group %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Only the members can change. New certificates are added before old ones are removed, so the group
+// keeps verifying whatever uses it while a CA certificate is rotated.
+func (r *certificateGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state certificateGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wanted, diags := stringsFromSet(ctx, plan.Certificates)
+	resp.Diagnostics.Append(diags...)
+	current, diags := stringsFromSet(ctx, state.Certificates)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if wanted != nil {
+		inCurrent := map[string]bool{}
+		if current != nil {
+			for _, name := range *current {
+				inCurrent[name] = true
+			}
+		}
+		inWanted := map[string]bool{}
+		var toAdd, toRemove []string
+		for _, name := range *wanted {
+			inWanted[name] = true
+			if !inCurrent[name] {
+				toAdd = append(toAdd, name)
+			}
+		}
+		for name := range inCurrent {
+			if !inWanted[name] {
+				toRemove = append(toRemove, name)
+			}
+		}
+
+		groupName := state.Name.ValueString()
+		if len(toAdd) > 0 {
+			if err := r.client.AddCertificateGroupCertificates(ctx, groupName, toAdd); err != nil {
+				resp.Diagnostics.AddError("Error Adding Certificates", fmt.Sprintf("Could not add certificates to certificate group %s: %s", groupName, err.Error()))
+				return
+			}
+		}
+		if len(toRemove) > 0 {
+			if err := r.client.RemoveCertificateGroupCertificates(ctx, groupName, toRemove); err != nil {
+				resp.Diagnostics.AddError("Error Removing Certificates", fmt.Sprintf("Could not remove certificates from certificate group %s: %s", groupName, err.Error()))
+				return
+			}
+		}
+	}
+
+	plan.ID = state.ID
+	if err := r.readCertificates(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Error Reading Certificate Group", fmt.Sprintf("Could not read the certificates of certificate group %s: %s", plan.Name.ValueString(), err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *certificateGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state certificateGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCertificateGroup(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Certificate Group", fmt.Sprintf("Could not delete certificate group %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *certificateGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *certificateGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &directoryServiceResource{}
+	_ resource.ResourceWithConfigure      = &directoryServiceResource{}
+	_ resource.ResourceWithImportState    = &directoryServiceResource{}
+	_ resource.ResourceWithValidateConfig = &directoryServiceResource{}
+)
+
+func NewDirectoryServiceResource() resource.Resource {
+	return &directoryServiceResource{}
+}
+
+type directoryServiceResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type directoryServiceResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	URIs                types.List   `tfsdk:"uris"`
+	BaseDn              types.String `tfsdk:"base_dn"`
+	BindUser            types.String `tfsdk:"bind_user"`
+	BindPasswordWO      types.String `tfsdk:"bind_password_wo"`
+	BindPasswordVersion types.Int64  `tfsdk:"bind_password_wo_version"`
+	CaCertificate       types.String `tfsdk:"ca_certificate"`
+	CaCertificateGroup  types.String `tfsdk:"ca_certificate_group"`
+	Services            types.Set    `tfsdk:"services"`
+	UserLoginAttribute  types.String `tfsdk:"user_login_attribute"`
+	UserObjectClass     types.String `tfsdk:"user_object_class"`
+	NisDomains          types.Set    `tfsdk:"nis_domains"`
+	NisServers          types.Set    `tfsdk:"nis_servers"`
+	JoinOu              types.String `tfsdk:"join_ou"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *directoryServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_service"
+}
+
+// --- SCHEMA ---
+func (r *directoryServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description:   description,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	optionalStringSet := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			Description:   description,
+			ElementType:   types.StringType,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manages one of the LDAP directory service configurations of a Pure Storage FlashBlade: `management` for administrator logins, `nfs` for NFS identity mapping, or `smb`. " +
+			"The array always has all three, so creating this resource adopts the named one and destroying it only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The directory service configuration to manage, `management`, `nfs` or `smb`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, the array uses the directory service.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"uris": schema.ListAttribute{
+				Description:   "The URIs of the LDAP servers, e.g. `ldaps://ldap.example.com`, in order of preference.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"base_dn":   optionalString("The base distinguished name searches start from, e.g. `DC=corp,DC=example,DC=com`."),
+			"bind_user": optionalString("The user the array binds to the LDAP servers as."),
+			"bind_password_wo": schema.StringAttribute{
+				Description: "The password of `bind_user`. This value is write-only and is never stored in the state.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"bind_password_wo_version": schema.Int64Attribute{
+				Description: "Change this value to send `bind_password_wo` to the array again, e.g. after rotating it.",
+				Optional:    true,
+			},
+			"ca_certificate":       optionalString("The name of the CA certificate used to verify the LDAP servers."),
+			"ca_certificate_group": optionalString("The name of the certificate group whose CA certificates are used to verify the LDAP servers."),
+			"services": schema.SetAttribute{
+				Description:   "The services the configuration is used for.",
+				ElementType:   types.StringType,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"user_login_attribute": optionalString("Only for `management`. The attribute that holds the login name of administrators, e.g. `sAMAccountName`."),
+			"user_object_class":    optionalString("Only for `management`. The object class of administrator accounts, e.g. `User`."),
+			"nis_domains":          optionalStringSet("Only for `nfs`. The NIS domains to look up netgroups in."),
+			"nis_servers":          optionalStringSet("Only for `nfs`. The NIS servers to look up netgroups on."),
+			"join_ou":              optionalString("Only for `smb`. The organizational unit the computer account is placed in."),
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, the connection to the LDAP servers is tested after the configuration is applied. Each failed check is reported as an error.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// directoryServiceOnlyAttributes lists the attributes that only apply to one of the configurations.
+var directoryServiceOnlyAttributes = map[string][]string{
+	"management": {"user_login_attribute", "user_object_class"},
+	"nfs":        {"nis_domains", "nis_servers"},
+	"smb":        {"join_ou"},
+}
+
+func (r *directoryServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config directoryServiceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Name.IsUnknown() {
+		return
+	}
+	name := config.Name.ValueString()
+	if _, ok := directoryServiceOnlyAttributes[name]; !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Directory Service Name", fmt.Sprintf("`name` must be `management`, `nfs` or `smb`, got %q.", name))
+		return
+	}
+
+	configured := map[string]bool{
+		"user_login_attribute": !config.UserLoginAttribute.IsNull(),
+		"user_object_class":    !config.UserObjectClass.IsNull(),
+		"nis_domains":          !config.NisDomains.IsNull(),
+		"nis_servers":          !config.NisServers.IsNull(),
+		"join_ou":              !config.JoinOu.IsNull(),
+	}
+	for service, attributes := range directoryServiceOnlyAttributes {
+		if service == name {
+			continue
+		}
+		for _, attribute := range attributes {
+			if configured[attribute] {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Directory Service Attribute",
+					fmt.Sprintf("`%s` only applies to the `%s` directory service, not `%s`.", attribute, service, name))
+			}
+		}
+	}
+}
+
+// Map FB API directory service to resource model. The bind password is deliberately left alone,
+// because it is write-only.
+func mapDirectoryServiceToModel(ds *fb.DirectoryService, model *directoryServiceResourceModel) {
+	model.ID = types.StringPointerValue(ds.Id)
+	model.Name = types.StringPointerValue(ds.Name)
+	model.Enabled = types.BoolPointerValue(ds.Enabled)
+	model.URIs = stringListValue(ds.Uris)
+	model.BaseDn = types.StringPointerValue(ds.BaseDn)
+	model.BindUser = types.StringPointerValue(ds.BindUser)
+	model.Services = stringSetValue(ds.Services)
+	model.CaCertificate = types.StringNull()
+	if ds.CaCertificate != nil {
+		model.CaCertificate = types.StringPointerValue(ds.CaCertificate.Name)
+	}
+	model.CaCertificateGroup = types.StringNull()
+	if ds.CaCertificateGroup != nil {
+		model.CaCertificateGroup = types.StringPointerValue(ds.CaCertificateGroup.Name)
+	}
+
+	model.UserLoginAttribute = types.StringNull()
+	model.UserObjectClass = types.StringNull()
+	if ds.Management != nil {
+		model.UserLoginAttribute = types.StringPointerValue(ds.Management.UserLoginAttribute)
+		model.UserObjectClass = types.StringPointerValue(ds.Management.UserObjectClass)
+	}
+	model.NisDomains = types.SetNull(types.StringType)
+	model.NisServers = types.SetNull(types.StringType)
+	if ds.Nfs != nil {
+		model.NisDomains = stringSetValue(ds.Nfs.NisDomains)
+		model.NisServers = stringSetValue(ds.Nfs.NisServers)
+	}
+	model.JoinOu = types.StringNull()
+	if ds.Smb != nil {
+		model.JoinOu = types.StringPointerValue(ds.Smb.JoinOu)
+	}
+}
+
+// directoryServiceFromPlan builds the patch for the configuration. The bind password is left out
+// when password is null, so that it isn't sent on every update.
+func directoryServiceFromPlan(ctx context.Context, plan directoryServiceResourceModel, password types.String) (fb.DirectoryService, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ds := fb.DirectoryService{
+		Enabled:      knownBoolPointer(plan.Enabled),
+		BaseDn:       knownStringPointer(plan.BaseDn),
+		BindUser:     knownStringPointer(plan.BindUser),
+		BindPassword: knownStringPointer(password),
+	}
+	if name := knownStringPointer(plan.CaCertificate); name != nil {
+		ds.CaCertificate = &fb.Reference{Name: name}
+	}
+	if name := knownStringPointer(plan.CaCertificateGroup); name != nil {
+		ds.CaCertificateGroup = &fb.Reference{Name: name}
+	}
+
+	var d diag.Diagnostics
+	ds.Uris, d = stringsFromList(ctx, plan.URIs)
+	diags.Append(d...)
+
+	switch plan.Name.ValueString() {
+	case "management":
+		ds.Management = &fb.DirectoryServiceManagement{
+			UserLoginAttribute: knownStringPointer(plan.UserLoginAttribute),
+			UserObjectClass:    knownStringPointer(plan.UserObjectClass),
+		}
+	case "nfs":
+		ds.Nfs = &fb.DirectoryServiceNfs{}
+		ds.Nfs.NisDomains, d = stringsFromSet(ctx, plan.NisDomains)
+		diags.Append(d...)
+		ds.Nfs.NisServers, d = stringsFromSet(ctx, plan.NisServers)
+		diags.Append(d...)
+	case "smb":
+		ds.Smb = &fb.DirectoryServiceSmb{JoinOu: knownStringPointer(plan.JoinOu)}
+	}
+	return ds, diags
+}
+
+// update patches the configuration with whatever the plan sets. The configuration to patch is
+// addressed by its fixed name.
+func (r *directoryServiceResource) update(ctx context.Context, plan *directoryServiceResourceModel, password types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	dsToUpdate, d := directoryServiceFromPlan(ctx, *plan, password)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := plan.Name.ValueString()
+	updated, err := r.client.UpdateDirectoryService(ctx, name, &dsToUpdate)
+	if err != nil {
+		diags.AddError("Error Updating Directory Service", fmt.Sprintf("Could not update directory service %s: %s", name, err.Error()))
+		return diags
+	}
+	mapDirectoryServiceToModel(updated, plan)
+	return diags
+}
+
+// test checks the connection to the LDAP servers if test_on_apply is set.
+func (r *directoryServiceResource) test(ctx context.Context, model directoryServiceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestDirectoryService(ctx, name)
+	if err != nil {
+		diags.AddError("Error Testing Directory Service", fmt.Sprintf("Could not test directory service %s: %s", name, err.Error()))
+		return diags
+	}
+	return testResultDiagnostics("Directory Service Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *directoryServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directoryServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bind_password_wo"), &password)...)
+	resp.Diagnostics.Append(r.update(ctx, &plan, password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *directoryServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directoryServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ds, err := r.client.GetDirectoryServiceByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Directory Service", fmt.Sprintf("Could not read directory service %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if ds == nil {
+		tflog.Warn(ctx, "Directory service not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapDirectoryServiceToModel(ds, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Write-only values never show up in a plan diff, so the bind password is only sent again when
+// bind_password_wo_version changes.
+func (r *directoryServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state directoryServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password := types.StringNull()
+	if !plan.BindPasswordVersion.Equal(state.BindPasswordVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bind_password_wo"), &password)...)
+	}
+	resp.Diagnostics.Append(r.update(ctx, &plan, password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The directory service configurations always exist, so the configuration is left as it is and
+// only dropped from the state.
+func (r *directoryServiceResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Directory service cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *directoryServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Directory services are imported by name, e.g. `nfs`. The bind password is kept until
+// bind_password_wo_version is changed.
+func (r *directoryServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &directoryServiceRoleResource{}
+	_ resource.ResourceWithConfigure   = &directoryServiceRoleResource{}
+	_ resource.ResourceWithImportState = &directoryServiceRoleResource{}
+)
+
+func NewDirectoryServiceRoleResource() resource.Resource {
+	return &directoryServiceRoleResource{}
+}
+
+type directoryServiceRoleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type directoryServiceRoleResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Group     types.String `tfsdk:"group"`
+	GroupBase types.String `tfsdk:"group_base"`
+	Role      types.String `tfsdk:"role"`
+}
+
+func (r *directoryServiceRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory_service_role"
+}
+
+// --- SCHEMA ---
+func (r *directoryServiceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Maps an LDAP group to an administrative role on a Pure Storage FlashBlade, so that members of the group can log in through the `management` `flashblade_directory_service`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the role mapping.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"group": schema.StringAttribute{
+				Description: "The common name of the LDAP group, e.g. `storage-admins`.",
+				Required:    true,
+			},
+			"group_base": schema.StringAttribute{
+				Description:   "The organizational unit the group is searched for in, relative to the `base_dn` of the directory service, e.g. `OU=Groups`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role granted to members of the group, e.g. `array_admin`, `storage_admin`, `ops_admin` or `readonly`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Map FB API directory service role to resource model
+func mapDirectoryServiceRoleToModel(role *fb.DirectoryServiceRole, model *directoryServiceRoleResourceModel) {
+	model.ID = types.StringPointerValue(role.Id)
+	model.Name = types.StringPointerValue(role.Name)
+	model.Group = types.StringPointerValue(role.Group)
+	model.GroupBase = types.StringPointerValue(role.GroupBase)
+	if role.Role != nil {
+		model.Role = types.StringPointerValue(role.Role.Name)
+	}
+}
+
+func directoryServiceRoleFromPlan(plan directoryServiceRoleResourceModel) fb.DirectoryServiceRole {
+	return fb.DirectoryServiceRole{
+		Group:     plan.Group.ValueStringPointer(),
+		GroupBase: knownStringPointer(plan.GroupBase),
+		Role:      &fb.ReferenceWritable{Name: plan.Role.ValueStringPointer()},
+	}
+}
+
+// --- CREATE ---
+func (r *directoryServiceRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directoryServiceRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleToCreate := directoryServiceRoleFromPlan(plan)
+	createdRole, err := r.client.CreateDirectoryServiceRole(ctx, plan.Name.ValueString(), &roleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Directory Service Role", "Could not create directory service role: "+err.Error())
+		return
+	}
+
+	mapDirectoryServiceRoleToModel(createdRole, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *directoryServiceRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directoryServiceRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetDirectoryServiceRoleByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Directory Service Role", fmt.Sprintf("Could not read directory service role %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if role == nil {
+		tflog.Warn(ctx, "Directory service role not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapDirectoryServiceRoleToModel(role, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *directoryServiceRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan directoryServiceRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleToUpdate := directoryServiceRoleFromPlan(plan)
+	updatedRole, err := r.client.UpdateDirectoryServiceRole(ctx, plan.Name.ValueString(), &roleToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Directory Service Role", fmt.Sprintf("Could not update directory service role: %s", err.Error()))
+		return
+	}
+
+	mapDirectoryServiceRoleToModel(updatedRole, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *directoryServiceRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state directoryServiceRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDirectoryServiceRole(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Directory Service Role", fmt.Sprintf("Could not delete directory service role %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *directoryServiceRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *directoryServiceRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
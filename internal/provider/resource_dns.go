@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &dnsResource{}
+	_ resource.ResourceWithConfigure   = &dnsResource{}
+	_ resource.ResourceWithImportState = &dnsResource{}
+)
+
+func NewDnsResource() resource.Resource {
+	return &dnsResource{}
+}
+
+type dnsResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type dnsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Domain      types.String `tfsdk:"domain"`
+	Nameservers types.List   `tfsdk:"nameservers"`
+	Services    types.Set    `tfsdk:"services"`
+	Sources     types.Set    `tfsdk:"sources"`
+}
+
+func (r *dnsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns"
+}
+
+// --- SCHEMA ---
+func (r *dnsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the DNS configuration of a Pure Storage FlashBlade. " +
+			"The array always has one, so creating this resource adopts it and destroying it only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{Description: "The name of the DNS configuration.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"domain": schema.StringAttribute{
+				Description:   "The domain suffix appended by the array to unqualified names, e.g. `corp.example.com`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"nameservers": schema.ListAttribute{
+				Description:   "The IP addresses of the name servers, in the order they are queried.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"services": schema.SetAttribute{
+				Description:   "The services that use this DNS configuration, e.g. `management` or `data`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"sources": schema.SetAttribute{
+				Description:   "The names of the network interfaces DNS queries are sent from.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Map FB API DNS configuration to resource model
+func mapDnsToModel(d *fb.Dns, model *dnsResourceModel) {
+	model.ID = types.StringPointerValue(d.Id)
+	model.Name = types.StringPointerValue(d.Name)
+	model.Domain = types.StringPointerValue(d.Domain)
+	model.Nameservers = stringListValue(d.Nameservers)
+	model.Services = stringSetValue(d.Services)
+
+	var sources []string
+	if d.Sources != nil {
+		for _, s := range *d.Sources {
+			if s.Name != nil {
+				sources = append(sources, *s.Name)
+			}
+		}
+	}
+	model.Sources = stringSetValue(&sources)
+}
+
+func dnsFromPlan(ctx context.Context, plan dnsResourceModel) (fb.Dns, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	dns := fb.Dns{Domain: knownStringPointer(plan.Domain)}
+
+	var d diag.Diagnostics
+	dns.Nameservers, d = stringsFromList(ctx, plan.Nameservers)
+	diags.Append(d...)
+	dns.Services, d = stringsFromSet(ctx, plan.Services)
+	diags.Append(d...)
+	sources, d := stringsFromSet(ctx, plan.Sources)
+	diags.Append(d...)
+	if sources != nil {
+		refs := make([]fb.Reference, 0, len(*sources))
+		for _, name := range *sources {
+			refs = append(refs, fb.Reference{Name: &name})
+		}
+		dns.Sources = &refs
+	}
+	return dns, diags
+}
+
+// update patches the DNS configuration with whatever the plan sets. The configuration is looked
+// up first, since the singleton is addressed by its name.
+func (r *dnsResource) update(ctx context.Context, plan *dnsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	current, err := r.client.GetDns(ctx)
+	if err != nil {
+		diags.AddError("Error Reading DNS", "Could not read the DNS configuration: "+err.Error())
+		return diags
+	}
+
+	dnsToUpdate, d := dnsFromPlan(ctx, *plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if dnsToUpdate.Domain == nil && dnsToUpdate.Nameservers == nil && dnsToUpdate.Services == nil && dnsToUpdate.Sources == nil {
+		mapDnsToModel(current, plan)
+		return diags
+	}
+
+	updated, err := r.client.UpdateDns(ctx, types.StringPointerValue(current.Name).ValueString(), &dnsToUpdate)
+	if err != nil {
+		diags.AddError("Error Updating DNS", "Could not update the DNS configuration: "+err.Error())
+		return diags
+	}
+	mapDnsToModel(updated, plan)
+	return diags
+}
+
+// --- CREATE ---
+func (r *dnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *dnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dns, err := r.client.GetDns(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading DNS", "Could not read the DNS configuration: "+err.Error())
+		return
+	}
+
+	mapDnsToModel(dns, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *dnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The array can't be left without DNS, so the configuration is left as it is and only dropped
+// from the state.
+func (r *dnsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "DNS configuration cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *dnsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one DNS configuration, so any import ID will do.
+func (r *dnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
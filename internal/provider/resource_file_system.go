@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 	
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,13 +27,22 @@ var (
 	_ resource.Resource                = &fileSystemResource{}
 	_ resource.ResourceWithConfigure   = &fileSystemResource{}
 	_ resource.ResourceWithImportState = &fileSystemResource{}
+	_ resource.ResourceWithValidateConfig = &fileSystemResource{}
+	_ resource.ResourceWithModifyPlan     = &fileSystemResource{}
 )
 
 // Define the attribute types for our nested objects.
+// defaultPromotionTimeout is how long a promotion or demotion may take unless promotion_timeout is set.
+const defaultPromotionTimeout = 20 * time.Minute
+
+// privateImported is the private state key marking a file system as imported, until source_snapshot is first recorded for it.
+const privateImported = "imported"
+
 var nfsAttributeTypes = map[string]attr.Type{
 	"v3_enabled":   types.BoolType,
 	"v4_1_enabled": types.BoolType,
 	"rules":        types.StringType,
+	"export_policy_name": types.StringType,
 }
 
 var smbAttributeTypes = map[string]attr.Type{
@@ -64,7 +76,13 @@ type fileSystemResourceModel struct {
 	SnapshotDirectoryEnabled   types.Bool   `tfsdk:"snapshot_directory_enabled"`
 	Writable                   types.Bool   `tfsdk:"writable"`
 	RequestedPromotionState    types.String `tfsdk:"requested_promotion_state"`
+	PromotionStatus            types.String `tfsdk:"promotion_status"`
+	PromotionTimeout           types.String `tfsdk:"promotion_timeout"`
+	DemoteDiscardNonSnapshottedData types.Bool `tfsdk:"demote_discard_non_snapshotted_data"`
 	QosPolicyName              types.String `tfsdk:"qos_policy_name"`
+	PolicyNames                types.Set    `tfsdk:"policy_names"`
+	SourceSnapshot             types.String `tfsdk:"source_snapshot"`
+	RestoreFromSnapshot        types.Bool   `tfsdk:"restore_from_snapshot"`
 	Created                    types.Int64  `tfsdk:"created"`
 	Destroyed                  types.Bool   `tfsdk:"destroyed"`
 	TimeRemaining              types.Int64  `tfsdk:"time_remaining"`
@@ -77,6 +95,7 @@ type nfsModel struct {
 	V3Enabled  types.Bool   `tfsdk:"v3_enabled"`
 	V41Enabled types.Bool   `tfsdk:"v4_1_enabled"`
 	Rules      types.String `tfsdk:"rules"`
+	ExportPolicyName types.String `tfsdk:"export_policy_name"`
 }
 
 type smbModel struct {
@@ -110,13 +129,13 @@ func (r *fileSystemResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"default_group_quota": schema.Int64Attribute{
-				Description:   "The default space quota for a group writing to this file system.",
+				Description:   "The default space quota for a group writing to this file system. Override it per group with `flashblade_group_quota`.",
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"default_user_quota": schema.Int64Attribute{
-				Description:   "The default space quota for a user writing to this file system.",
+				Description:   "The default space quota for a user writing to this file system. Override it per user with `flashblade_user_quota`.",
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
@@ -134,12 +153,43 @@ func (r *fileSystemResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"requested_promotion_state": schema.StringAttribute{
-				Description:   "The requested promotion state of the file system. Can be `promoted` or `demoted`.",
+				Description:   "The requested promotion state of the file system. Can be `promoted` or `demoted`. Only file systems with a replica link can be demoted. " +
+					"Applying a change waits until `promotion_status` reaches the requested state.",
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"qos_policy_name": schema.StringAttribute{Description: "The name of the Quality of Service policy for the file system.", Optional: true, Computed: true},
+			"promotion_status": schema.StringAttribute{
+				Description:   "The current promotion status of the file system, `promoted` or `demoted`.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"promotion_timeout": schema.StringAttribute{
+				Description: "How long to wait for a promotion or demotion to complete, as a Go duration such as `30m`. Defaults to `20m`.",
+				Optional:    true,
+			},
+			"demote_discard_non_snapshotted_data": schema.BoolAttribute{
+				Description: "Must be set to true to demote the file system, acknowledging that data written since its last replicated snapshot is discarded.",
+				Optional:    true,
+			},
+			"qos_policy_name": schema.StringAttribute{Description: "The name of the Quality of Service policy for the file system, e.g. the `name` of a `flashblade_qos_policy`.", Optional: true, Computed: true},
+			"policy_names": schema.SetAttribute{
+				Description: "Snapshot policies attached to the file system when it is created, so it is protected from the moment it exists. " +
+					"Changes are applied by attaching and detaching policies, and policies attached or detached outside of Terraform show up as drift. Don't combine with `flashblade_file_system_policy_attachment` for the same file system.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"source_snapshot": schema.StringAttribute{
+				Description: "The name of a file system snapshot, e.g. `prod.nightly`, to create the file system as a clone of. " +
+					"Changing it replaces the file system, unless `restore_from_snapshot` is set. Setting it for the first time after importing a file system only records it.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIf(sourceSnapshotRequiresReplace, "Replaces the file system with a clone of the snapshot, unless `restore_from_snapshot` is set.", "Replaces the file system with a clone of the snapshot, unless `restore_from_snapshot` is set.")},
+			},
+			"restore_from_snapshot": schema.BoolAttribute{
+				Description: "If true, a change of `source_snapshot` overwrites the existing file system in place with the snapshot's contents. " +
+					"**All data written since the snapshot was taken is discarded.**",
+				Optional: true,
+			},
 			"created":         schema.Int64Attribute{Description: "Creation timestamp of the file system.", Computed: true},
 			"destroyed":       schema.BoolAttribute{Description: "Is the file system destroyed?", Computed: true},
 			"time_remaining":  schema.Int64Attribute{Description: "Time in milliseconds before the file system is eradicated.", Computed: true},
@@ -150,7 +200,13 @@ func (r *fileSystemResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Attributes: map[string]schema.Attribute{
 					"v3_enabled":   schema.BoolAttribute{Optional: true, Computed: true},
 					"v4_1_enabled": schema.BoolAttribute{Optional: true, Computed: true},
-					"rules":        schema.StringAttribute{Optional: true, Computed: true},
+					"rules":        schema.StringAttribute{Description: "Legacy NFS export rules in the `exports` format. Conflicts with `export_policy_name`.", Optional: true, Computed: true},
+					"export_policy_name": schema.StringAttribute{
+						Description:   "The name of the NFS export policy applied to the file system. Conflicts with `rules`. Setting `rules` instead detaches the policy.",
+						Optional:      true,
+						Computed:      true,
+						PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
 				},
 			},
 			"smb": schema.SingleNestedAttribute{
@@ -160,8 +216,8 @@ func (r *fileSystemResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Attributes: map[string]schema.Attribute{
 					"enabled":                         schema.BoolAttribute{Optional: true, Computed: true},
 					"continuous_availability_enabled": schema.BoolAttribute{Optional: true, Computed: true},
-					"client_policy_name":              schema.StringAttribute{Description: "The name of the SMB client policy.", Optional: true, Computed: true},
-					"share_policy_name":               schema.StringAttribute{Description: "The name of the SMB share policy.", Optional: true, Computed: true},
+					"client_policy_name":              schema.StringAttribute{Description: "The name of the SMB client policy, e.g. the `name` of a `flashblade_smb_client_policy`.", Optional: true, Computed: true},
+					"share_policy_name":               schema.StringAttribute{Description: "The name of the SMB share policy, e.g. the `name` of a `flashblade_smb_share_policy`.", Optional: true, Computed: true},
 				},
 			},
 			"multi_protocol": schema.SingleNestedAttribute{
@@ -187,16 +243,22 @@ func mapFileSystemToModel(fs *fb.FileSystem, model *fileSystemResourceModel) {
 	model.DefaultUserQuota = types.Int64PointerValue(fs.DefaultUserQuota)
 	model.SnapshotDirectoryEnabled = types.BoolPointerValue(fs.SnapshotDirectoryEnabled)
 	model.Writable = types.BoolPointerValue(fs.Writable)
-	model.RequestedPromotionState = types.StringPointerValue(fs.PromotionStatus)
+	model.RequestedPromotionState = types.StringPointerValue(fs.RequestedPromotionState)
+	model.PromotionStatus = types.StringPointerValue(fs.PromotionStatus)
 	model.Created = types.Int64PointerValue(fs.Created)
 	model.Destroyed = types.BoolPointerValue(fs.Destroyed)
 	model.TimeRemaining = types.Int64PointerValue(fs.TimeRemaining)
 
 	if fs.Nfs != nil && (fs.Nfs.V3Enabled != nil || fs.Nfs.V41Enabled != nil) {
+		exportPolicyName := types.StringNull()
+		if fs.Nfs.ExportPolicy != nil && fs.Nfs.ExportPolicy.Name != nil && *fs.Nfs.ExportPolicy.Name != "" {
+			exportPolicyName = types.StringPointerValue(fs.Nfs.ExportPolicy.Name)
+		}
 		model.Nfs = basetypes.NewObjectValueMust(nfsAttributeTypes, map[string]attr.Value{
 			"v3_enabled":   types.BoolPointerValue(fs.Nfs.V3Enabled),
 			"v4_1_enabled": types.BoolPointerValue(fs.Nfs.V41Enabled),
 			"rules":        types.StringPointerValue(fs.Nfs.Rules),
+			"export_policy_name": exportPolicyName,
 		})
 	} else {
 		model.Nfs = types.ObjectNull(nfsAttributeTypes)
@@ -251,7 +313,7 @@ func (r *fileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		DefaultUserQuota:         plan.DefaultUserQuota.ValueInt64Pointer(),
 		SnapshotDirectoryEnabled: plan.SnapshotDirectoryEnabled.ValueBoolPointer(),
 		Writable:                 plan.Writable.ValueBoolPointer(),
-		RequestedPromotionState:  plan.RequestedPromotionState.ValueStringPointer(),
+		RequestedPromotionState:  knownStringPointer(plan.RequestedPromotionState),
 	}
 
 	if !plan.Nfs.IsNull() {
@@ -263,6 +325,10 @@ func (r *fileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 			V41Enabled: nfsData.V41Enabled.ValueBoolPointer(),
 			Rules:      nfsData.Rules.ValueStringPointer(),
 		}
+		if name := knownStringPointer(nfsData.ExportPolicyName); name != nil {
+			fsToCreate.Nfs.ExportPolicy = &fb.ReferenceWritable{Name: name}
+			fsToCreate.Nfs.Rules = nil
+		}
 	}
 	
 	if !plan.Smb.IsNull() {
@@ -285,16 +351,168 @@ func (r *fileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		fsToCreate.QosPolicy = &fb.Reference{Name: plan.QosPolicyName.ValueStringPointer()}
 	}
 
-	createdFS, err := r.client.CreateFileSystem(ctx, plan.Name.ValueString(), &fsToCreate)
+	if !plan.SourceSnapshot.IsNull() {
+		fsToCreate.Source = &fb.Reference{Name: plan.SourceSnapshot.ValueStringPointer()}
+	}
+
+	var policyNames []string
+	if !plan.PolicyNames.IsNull() {
+		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
+		if resp.Diagnostics.HasError() { return }
+	}
+
+	createdFS, err := r.client.CreateFileSystem(ctx, plan.Name.ValueString(), &fsToCreate, policyNames)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating File System", "Could not create file system: "+err.Error())
 		return
 	}
 	
+	if fsToCreate.RequestedPromotionState != nil {
+		promotedFS, err := r.waitForPromotion(ctx, plan)
+		if err != nil {
+			mapFileSystemToModel(createdFS, &plan)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddAttributeError(path.Root("requested_promotion_state"), "Error Waiting For Promotion State", err.Error())
+			return
+		}
+		createdFS = promotedFS
+	}
+
 	mapFileSystemToModel(createdFS, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// --- VALIDATE ---
+func (r *fileSystemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules, exportPolicyName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nfs").AtName("rules"), &rules)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nfs").AtName("export_policy_name"), &exportPolicyName)...)
+	if resp.Diagnostics.HasError() { return }
+
+	if !rules.IsNull() && !exportPolicyName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("nfs").AtName("export_policy_name"), "Conflicting NFS Export Configuration",
+			"`nfs.rules` and `nfs.export_policy_name` cannot both be set. Use an NFS export policy, or legacy rules, but not both.")
+	}
+
+	var sourceSnapshot types.String
+	var restoreFromSnapshot types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_snapshot"), &sourceSnapshot)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("restore_from_snapshot"), &restoreFromSnapshot)...)
+	if resp.Diagnostics.HasError() { return }
+
+	var requestedPromotionState, promotionTimeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("requested_promotion_state"), &requestedPromotionState)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("promotion_timeout"), &promotionTimeout)...)
+	if resp.Diagnostics.HasError() { return }
+
+	if state := requestedPromotionState.ValueString(); !requestedPromotionState.IsUnknown() && state != "" && state != "promoted" && state != "demoted" {
+		resp.Diagnostics.AddAttributeError(path.Root("requested_promotion_state"), "Invalid Promotion State",
+			fmt.Sprintf("`requested_promotion_state` must be `promoted` or `demoted`, got %q.", state))
+	}
+	if !promotionTimeout.IsNull() && !promotionTimeout.IsUnknown() {
+		if _, err := time.ParseDuration(promotionTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("promotion_timeout"), "Invalid Promotion Timeout",
+				fmt.Sprintf("`promotion_timeout` must be a duration such as `30m`: %s", err.Error()))
+		}
+	}
+
+	if restoreFromSnapshot.ValueBool() && sourceSnapshot.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("restore_from_snapshot"), "Missing Source Snapshot",
+			"`restore_from_snapshot` requires `source_snapshot` to name the snapshot to restore from.")
+	}
+}
+
+// sourceSnapshotRequiresReplace replaces the file system when it should be cloned from a different snapshot,
+// unless the user opted in to restoring the existing file system in place. The array doesn't report which snapshot
+// a file system was cloned from, so setting it for the first time on an imported file system only records it.
+func sourceSnapshotRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsNull() { return }
+	if req.StateValue.IsNull() {
+		imported, diags := req.Private.GetKey(ctx, privateImported)
+		resp.Diagnostics.Append(diags...)
+		if imported != nil { return }
+	}
+	var restoreFromSnapshot types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("restore_from_snapshot"), &restoreFromSnapshot)...)
+	resp.RequiresReplace = !restoreFromSnapshot.ValueBool()
+}
+
+// --- MODIFY PLAN ---
+// Restoring from a snapshot silently destroys data, so surface it at plan time as well as apply time.
+func (r *fileSystemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() { return }
+
+	var plan, state fileSystemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() { return }
+
+	if isSnapshotRestore(plan, state) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("source_snapshot"), "File System Will Be Restored From Snapshot", restoreWarning(plan))
+	}
+
+	// export_policy_name keeps its state value when it's left out, so switching to legacy rules has to clear it here.
+	var rules, exportPolicyName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nfs").AtName("rules"), &rules)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("nfs").AtName("export_policy_name"), &exportPolicyName)...)
+	if resp.Diagnostics.HasError() { return }
+	if !rules.IsNull() && exportPolicyName.IsNull() && !plan.Nfs.IsNull() && !plan.Nfs.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nfs").AtName("export_policy_name"), types.StringNull())...)
+	}
+
+	// promotion_status otherwise keeps its state value, so it is only left unknown when the requested state is.
+	if plan.RequestedPromotionState.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("promotion_status"), types.StringUnknown())...)
+		return
+	}
+	if !plan.RequestedPromotionState.Equal(state.RequestedPromotionState) {
+		if plan.RequestedPromotionState.ValueString() == "demoted" && !plan.DemoteDiscardNonSnapshottedData.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("demote_discard_non_snapshotted_data"), "Demotion Not Acknowledged",
+				fmt.Sprintf("Demoting file system %s discards any data written to it since its last replicated snapshot. Set `demote_discard_non_snapshotted_data = true` to proceed.", plan.Name.ValueString()))
+			return
+		}
+		// Update waits for the transition, so the observed status is known to end up as requested.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("promotion_status"), plan.RequestedPromotionState)...)
+	}
+}
+
+// waitForPromotion polls the file system until its promotion status matches the requested state.
+func (r *fileSystemResource) waitForPromotion(ctx context.Context, plan fileSystemResourceModel) (*fb.FileSystem, error) {
+	timeout := defaultPromotionTimeout
+	if !plan.PromotionTimeout.IsNull() {
+		parsed, err := time.ParseDuration(plan.PromotionTimeout.ValueString())
+		if err != nil { return nil, fmt.Errorf("invalid promotion_timeout: %w", err) }
+		timeout = parsed
+	}
+
+	name, requested := plan.Name.ValueString(), plan.RequestedPromotionState.ValueString()
+	tflog.Debug(ctx, "Waiting for file system promotion status...", map[string]interface{}{"name": name, "requested": requested, "timeout": timeout.String()})
+	var fs *fb.FileSystem
+	err := waitFor(ctx, timeout, func() (bool, string, error) {
+		current, err := r.client.GetFileSystemByName(ctx, name)
+		if err != nil { return false, "", err }
+		if current == nil { return false, "", fmt.Errorf("file system %s no longer exists", name) }
+		fs = current
+		status := types.StringPointerValue(current.PromotionStatus).ValueString()
+		return status == requested, status, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("file system %s did not become %s: %w. Increase `promotion_timeout` if the transition is still in progress", name, requested, err)
+	}
+	return fs, nil
+}
+
+// isSnapshotRestore reports whether applying the plan overwrites the existing file system with a snapshot.
+func isSnapshotRestore(plan, state fileSystemResourceModel) bool {
+	return plan.RestoreFromSnapshot.ValueBool() && !plan.SourceSnapshot.IsNull() && !plan.SourceSnapshot.IsUnknown() &&
+		!plan.SourceSnapshot.Equal(state.SourceSnapshot)
+}
+
+func restoreWarning(plan fileSystemResourceModel) string {
+	return fmt.Sprintf("File system %s is overwritten with the contents of snapshot %s. Any data written to it since the snapshot was taken is discarded and cannot be recovered.",
+		plan.Name.ValueString(), plan.SourceSnapshot.ValueString())
+}
+
 // --- READ ---
 func (r *fileSystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state fileSystemResourceModel
@@ -314,9 +532,30 @@ func (r *fileSystemResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	
 	mapFileSystemToModel(fs, &state)
+	resp.Diagnostics.Append(r.readPolicyNames(ctx, &state)...)
+	if resp.Diagnostics.HasError() { return }
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// readPolicyNames refreshes policy_names from the snapshot policies attached on the array. It is left alone when
+// the attribute is not set, so attachments managed by flashblade_file_system_policy_attachment don't show up as drift.
+func (r *fileSystemResource) readPolicyNames(ctx context.Context, model *fileSystemResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.PolicyNames.IsNull() { return diags }
+
+	names, err := r.client.ListFileSystemSnapshotPolicies(ctx, model.Name.ValueString())
+	if err != nil {
+		diags.AddError("Error Reading File System Policies", fmt.Sprintf("Could not list policies attached to file system %s: %s", model.Name.ValueString(), err.Error()))
+		return diags
+	}
+	if len(names) == 0 {
+		model.PolicyNames = types.SetValueMust(types.StringType, []attr.Value{})
+		return diags
+	}
+	model.PolicyNames = stringSetValue(&names)
+	return diags
+}
+
 // --- UPDATE ---
 func (r *fileSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state fileSystemResourceModel
@@ -324,6 +563,19 @@ func (r *fileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() { return }
 
+	restored := isSnapshotRestore(plan, state)
+	if restored {
+		tflog.Warn(ctx, "Restoring file system from snapshot, discarding non-snapshotted data.", map[string]interface{}{"name": plan.Name.ValueString(), "snapshot": plan.SourceSnapshot.ValueString()})
+		if _, err := r.client.RestoreFileSystemFromSnapshot(ctx, plan.Name.ValueString(), plan.SourceSnapshot.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error Restoring File System", fmt.Sprintf("Could not restore file system %s from snapshot %s: %s", plan.Name.ValueString(), plan.SourceSnapshot.ValueString(), err.Error()))
+			return
+		}
+		resp.Diagnostics.AddAttributeWarning(path.Root("source_snapshot"), "File System Restored From Snapshot", restoreWarning(plan))
+	}
+	if !plan.SourceSnapshot.IsNull() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateImported, nil)...)
+	}
+
 	fsToUpdate := fb.FileSystemPatch{}
 	isPatchNeeded := false
 
@@ -333,7 +585,8 @@ func (r *fileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 	if !plan.DefaultUserQuota.Equal(state.DefaultUserQuota) { isPatchNeeded = true; fsToUpdate.DefaultUserQuota = plan.DefaultUserQuota.ValueInt64Pointer() }
 	if !plan.SnapshotDirectoryEnabled.Equal(state.SnapshotDirectoryEnabled) { isPatchNeeded = true; fsToUpdate.SnapshotDirectoryEnabled = plan.SnapshotDirectoryEnabled.ValueBoolPointer() }
 	if !plan.Writable.Equal(state.Writable) { isPatchNeeded = true; fsToUpdate.Writable = plan.Writable.ValueBoolPointer() }
-	if !plan.RequestedPromotionState.Equal(state.RequestedPromotionState) { isPatchNeeded = true; fsToUpdate.RequestedPromotionState = plan.RequestedPromotionState.ValueStringPointer() }
+	promotionChanged := !plan.RequestedPromotionState.IsUnknown() && !plan.RequestedPromotionState.Equal(state.RequestedPromotionState)
+	if promotionChanged { isPatchNeeded = true; fsToUpdate.RequestedPromotionState = plan.RequestedPromotionState.ValueStringPointer() }
 
 	if !plan.QosPolicyName.Equal(state.QosPolicyName) {
 		isPatchNeeded = true
@@ -351,6 +604,16 @@ func (r *fileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 				V41Enabled: planNfs.V41Enabled.ValueBoolPointer(),
 				Rules:      planNfs.Rules.ValueStringPointer(),
 			}
+			if name := knownStringPointer(planNfs.ExportPolicyName); name != nil {
+				fsToUpdate.Nfs.ExportPolicy = &fb.ReferenceWritable{Name: name}
+				fsToUpdate.Nfs.Rules = nil
+			} else if planNfs.ExportPolicyName.IsNull() && !state.Nfs.IsNull() {
+				// Switching back to legacy rules requires detaching the export policy first.
+				var stateNfs nfsModel
+				resp.Diagnostics.Append(state.Nfs.As(ctx, &stateNfs, basetypes.ObjectAsOptions{})...)
+				if resp.Diagnostics.HasError() { return }
+				if !stateNfs.ExportPolicyName.IsNull() { fsToUpdate.Nfs.ExportPolicy = &fb.ReferenceWritable{Name: types.StringValue("").ValueStringPointer()} }
+			}
 		} else {
 			fsToUpdate.Nfs = &fb.NfsPatch{ V3Enabled: types.BoolValue(false).ValueBoolPointer(), V41Enabled: types.BoolValue(false).ValueBoolPointer(), Rules: types.StringValue("").ValueStringPointer() }
 		}
@@ -377,22 +640,84 @@ func (r *fileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 	
+	if !plan.PolicyNames.Equal(state.PolicyNames) {
+		resp.Diagnostics.Append(r.updatePolicyAttachments(ctx, plan.Name.ValueString(), plan.PolicyNames, state.PolicyNames)...)
+		if resp.Diagnostics.HasError() { return }
+	}
+
 	if !isPatchNeeded {
-		tflog.Debug(ctx, "No changes detected for file system, skipping API call.")
+		// Values the array computes may still have changed, so read the file system instead of patching it.
+		tflog.Debug(ctx, "No changes detected for file system, reading it instead of updating it.")
+		fs, err := r.client.GetFileSystemByName(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading File System", fmt.Sprintf("Could not read file system %s: %s", plan.Name.ValueString(), err.Error()))
+			return
+		}
+		if fs == nil {
+			resp.Diagnostics.AddError("Error Reading File System", fmt.Sprintf("File system %s no longer exists.", plan.Name.ValueString()))
+			return
+		}
+		mapFileSystemToModel(fs, &plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 		return
 	}
 
-	updatedFS, err := r.client.UpdateFileSystem(ctx, plan.Name.ValueString(), &fsToUpdate)
+	discard := promotionChanged && plan.RequestedPromotionState.ValueString() == "demoted" && plan.DemoteDiscardNonSnapshottedData.ValueBool()
+	updatedFS, err := r.client.UpdateFileSystem(ctx, plan.Name.ValueString(), &fsToUpdate, discard)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Updating File System", fmt.Sprintf("Could not update file system: %s", err.Error()))
 		return
 	}
 
+	if promotionChanged {
+		promotedFS, err := r.waitForPromotion(ctx, plan)
+		if err != nil {
+			// The patch was accepted and the array keeps transitioning, so record it; the next refresh shows the outcome.
+			mapFileSystemToModel(updatedFS, &plan)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddAttributeError(path.Root("requested_promotion_state"), "Error Waiting For Promotion State", err.Error())
+			return
+		}
+		updatedFS = promotedFS
+	}
+
 	mapFileSystemToModel(updatedFS, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 
+// updatePolicyAttachments attaches policies added to policy_names and detaches removed ones. Only snapshot
+// policies that are attached on the array are detached, so policies managed by other resources are never touched.
+func (r *fileSystemResource) updatePolicyAttachments(ctx context.Context, fsName string, planned, current types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var plannedNames, currentNames []string
+	if !planned.IsNull() { diags.Append(planned.ElementsAs(ctx, &plannedNames, false)...) }
+	if !current.IsNull() { diags.Append(current.ElementsAs(ctx, &currentNames, false)...) }
+	if diags.HasError() { return diags }
+
+	attached, err := r.client.ListFileSystemSnapshotPolicies(ctx, fsName)
+	if err != nil {
+		diags.AddError("Error Reading File System Policies", fmt.Sprintf("Could not list policies attached to file system %s: %s", fsName, err.Error()))
+		return diags
+	}
+
+	for _, name := range currentNames {
+		if slices.Contains(plannedNames, name) || !slices.Contains(attached, name) { continue }
+		if err := r.client.RemoveFileSystemPolicy(ctx, name, fsName); err != nil {
+			diags.AddError("Error Detaching Policy", fmt.Sprintf("Could not detach policy %s from file system %s: %s", name, fsName, err.Error()))
+			return diags
+		}
+	}
+	for _, name := range plannedNames {
+		if slices.Contains(attached, name) { continue }
+		if _, err := r.client.AddFileSystemPolicy(ctx, name, fsName); err != nil {
+			diags.AddError("Error Attaching Policy", fmt.Sprintf("Could not attach policy %s to file system %s: %s", name, fsName, err.Error()))
+			return diags
+		}
+	}
+	return diags
+}
+
 // --- DELETE ---
 func (r *fileSystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state fileSystemResourceModel
@@ -421,7 +746,7 @@ func (r *fileSystemResource) Delete(ctx context.Context, req resource.DeleteRequ
 			Nfs:       &fb.NfsPatch{V3Enabled: &shouldDisable, V41Enabled: &shouldDisable},
 			Smb:       &fb.Smb{Enabled: &shouldDisable},
 		}
-		_, err = r.client.UpdateFileSystem(ctx, fsName, &patch)
+		_, err = r.client.UpdateFileSystem(ctx, fsName, &patch, false)
 		if err != nil {
 			resp.Diagnostics.AddError("Error Marking File System For Deletion", fmt.Sprintf("Could not disable protocols and mark file system %s for deletion: %s", fsName, err.Error()))
 			return
@@ -452,4 +777,5 @@ func (r *fileSystemResource) Configure(_ context.Context, req resource.Configure
 // --- IMPORT ---
 func (r *fileSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateImported, []byte("true"))...)
 }
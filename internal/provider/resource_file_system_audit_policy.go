@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &fileSystemAuditPolicyResource{}
+	_ resource.ResourceWithConfigure   = &fileSystemAuditPolicyResource{}
+	_ resource.ResourceWithImportState = &fileSystemAuditPolicyResource{}
+)
+
+func NewFileSystemAuditPolicyResource() resource.Resource {
+	return &fileSystemAuditPolicyResource{}
+}
+
+type fileSystemAuditPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type fileSystemAuditPolicyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	LogTargets types.Set    `tfsdk:"log_targets"`
+	PolicyType types.String `tfsdk:"policy_type"`
+}
+
+func (r *fileSystemAuditPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_system_audit_policy"
+}
+
+// --- SCHEMA ---
+func (r *fileSystemAuditPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade file system audit policy, which logs SMB and NFS access to the file systems it is applied to. " +
+			"Apply it with `flashblade_file_system_audit_policy_attachment`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the audit policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, access to member file systems is audited.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"log_targets": schema.SetAttribute{
+				Description: "The names of the targets audit logs are written to, either file system log targets or remote syslog servers.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API audit policy to resource model
+func mapFileSystemAuditPolicyToModel(p *fb.AuditFileSystemsPolicy, model *fileSystemAuditPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+
+	var logTargets []string
+	if p.LogTargets != nil {
+		for _, t := range *p.LogTargets {
+			if t.Name != nil {
+				logTargets = append(logTargets, *t.Name)
+			}
+		}
+	}
+	model.LogTargets = stringSetValue(&logTargets)
+}
+
+// logTargetReferences converts the configured log target names into references.
+func logTargetReferences(ctx context.Context, v types.Set) (*[]fb.Reference, diag.Diagnostics) {
+	names, diags := stringsFromSet(ctx, v)
+	if names == nil {
+		return nil, diags
+	}
+	refs := make([]fb.Reference, 0, len(*names))
+	for _, name := range *names {
+		refs = append(refs, fb.Reference{Name: &name})
+	}
+	return &refs, diags
+}
+
+// --- CREATE ---
+func (r *fileSystemAuditPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileSystemAuditPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logTargets, diags := logTargetReferences(ctx, plan.LogTargets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	policyToCreate := fb.AuditFileSystemsPoliciesPost{
+		Enabled:    knownBoolPointer(plan.Enabled),
+		LogTargets: logTargets,
+	}
+	createdPolicy, err := r.client.CreateFileSystemAuditPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating File System Audit Policy", "Could not create file system audit policy: "+err.Error())
+		return
+	}
+
+	mapFileSystemAuditPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *fileSystemAuditPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileSystemAuditPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetFileSystemAuditPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading File System Audit Policy", fmt.Sprintf("Could not read file system audit policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "File system audit policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapFileSystemAuditPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *fileSystemAuditPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan fileSystemAuditPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// log_targets replaces the whole list, so targets removed from the configuration are dropped.
+	logTargets, diags := logTargetReferences(ctx, plan.LogTargets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	policyToUpdate := fb.AuditFileSystemsPoliciesPatch{
+		Enabled:    knownBoolPointer(plan.Enabled),
+		LogTargets: logTargets,
+	}
+	updatedPolicy, err := r.client.UpdateFileSystemAuditPolicy(ctx, plan.Name.ValueString(), &policyToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating File System Audit Policy", fmt.Sprintf("Could not update file system audit policy: %s", err.Error()))
+		return
+	}
+
+	mapFileSystemAuditPolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *fileSystemAuditPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fileSystemAuditPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFileSystemAuditPolicy(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting File System Audit Policy", fmt.Sprintf("Could not delete file system audit policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *fileSystemAuditPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *fileSystemAuditPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
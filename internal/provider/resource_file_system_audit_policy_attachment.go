@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+func NewFileSystemAuditPolicyAttachmentResource() resource.Resource {
+	return &policyAttachmentResource{policyAttachmentKind: policyAttachmentKind{
+		typeName:          "file_system_audit_policy_attachment",
+		description:       "Applies a Pure Storage FlashBlade audit policy to a file system, so SMB and NFS access to it is logged.",
+		policyTitle:       "Audit Policy",
+		policyLabel:       "audit policy",
+		policyDescription: "The name of the audit policy.",
+		memberAttribute:   "file_system",
+		memberLabel:       "file system",
+		memberDescription: "The name of the file system.",
+		add: func(ctx context.Context, c *client.Client, policy, member string) error {
+			_, err := c.AddFileSystemAuditPolicy(ctx, policy, member)
+			return err
+		},
+		exists: func(ctx context.Context, c *client.Client, policy, member string) (bool, error) {
+			membership, err := c.GetFileSystemAuditPolicy(ctx, policy, member)
+			return membership != nil, err
+		},
+		remove: func(ctx context.Context, c *client.Client, policy, member string) error {
+			return c.RemoveFileSystemAuditPolicy(ctx, policy, member)
+		},
+	}}
+}
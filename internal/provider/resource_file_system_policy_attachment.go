@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &fileSystemPolicyAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &fileSystemPolicyAttachmentResource{}
+	_ resource.ResourceWithImportState = &fileSystemPolicyAttachmentResource{}
+)
+
+func NewFileSystemPolicyAttachmentResource() resource.Resource {
+	return &fileSystemPolicyAttachmentResource{}
+}
+
+type fileSystemPolicyAttachmentResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type fileSystemPolicyAttachmentResourceModel struct {
+	Policy     types.String `tfsdk:"policy"`
+	FileSystem types.String `tfsdk:"file_system"`
+}
+
+func (r *fileSystemPolicyAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_system_policy_attachment"
+}
+
+// --- SCHEMA ---
+func (r *fileSystemPolicyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a Pure Storage FlashBlade snapshot policy to a file system.",
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{
+				Description:   "The name of the snapshot policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"file_system": schema.StringAttribute{
+				Description:   "The name of the file system.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+// --- CREATE ---
+func (r *fileSystemPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileSystemPolicyAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AddFileSystemPolicy(ctx, plan.Policy.ValueString(), plan.FileSystem.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Attaching Policy", fmt.Sprintf("Could not attach policy %s to file system %s: %s", plan.Policy.ValueString(), plan.FileSystem.ValueString(), err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *fileSystemPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileSystemPolicyAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	membership, err := r.client.GetFileSystemPolicy(ctx, state.Policy.ValueString(), state.FileSystem.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Policy Attachment", fmt.Sprintf("Could not read attachment of policy %s to file system %s: %s", state.Policy.ValueString(), state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	if membership == nil {
+		tflog.Warn(ctx, "File system policy attachment not found, removing from state.", map[string]interface{}{"policy": state.Policy.ValueString(), "file_system": state.FileSystem.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// --- UPDATE ---
+// Both attributes force replacement, so there is nothing to update.
+func (r *fileSystemPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+// --- DELETE ---
+func (r *fileSystemPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fileSystemPolicyAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveFileSystemPolicy(ctx, state.Policy.ValueString(), state.FileSystem.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Detaching Policy", fmt.Sprintf("Could not detach policy %s from file system %s: %s", state.Policy.ValueString(), state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *fileSystemPolicyAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Attachments are imported with an ID of the form "<policy>,<file_system>".
+func (r *fileSystemPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <policy>,<file_system>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_system"), parts[1])...)
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &fileSystemReplicaLinkResource{}
+	_ resource.ResourceWithConfigure   = &fileSystemReplicaLinkResource{}
+	_ resource.ResourceWithImportState = &fileSystemReplicaLinkResource{}
+)
+
+// replicaLinkHealthyTimeout bounds how long Create waits for a new link to start replicating.
+const replicaLinkHealthyTimeout = 10 * time.Minute
+
+func NewFileSystemReplicaLinkResource() resource.Resource {
+	return &fileSystemReplicaLinkResource{}
+}
+
+type fileSystemReplicaLinkResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type fileSystemReplicaLinkResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	LocalFileSystem           types.String `tfsdk:"local_file_system"`
+	Remote                    types.String `tfsdk:"remote"`
+	RemoteFileSystem          types.String `tfsdk:"remote_file_system"`
+	Policies                  types.Set    `tfsdk:"policies"`
+	CancelInProgressTransfers types.Bool   `tfsdk:"cancel_in_progress_transfers"`
+	Direction                 types.String `tfsdk:"direction"`
+	LinkType                  types.String `tfsdk:"link_type"`
+	Status                    types.String `tfsdk:"status"`
+	StatusDetails             types.String `tfsdk:"status_details"`
+	Lag                       types.Int64  `tfsdk:"lag"`
+	RecoveryPoint             types.Int64  `tfsdk:"recovery_point"`
+}
+
+func (r *fileSystemReplicaLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_system_replica_link"
+}
+
+// --- SCHEMA ---
+func (r *fileSystemReplicaLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the replication of a Pure Storage FlashBlade file system to a remote FlashBlade connected with `flashblade_array_connection`. " +
+			"Creating the link waits until it is healthy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"local_file_system": schema.StringAttribute{
+				Description:   "The name of the local file system to replicate.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"remote": schema.StringAttribute{
+				Description:   "The name of the remote array, e.g. the `remote_name` of a `flashblade_array_connection`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"remote_file_system": schema.StringAttribute{
+				Description:   "The name of the file system on the remote array, which is created if it does not exist. Defaults to the name of the local file system.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"policies": schema.SetAttribute{
+				Description: "The snapshot policies whose snapshots are replicated over the link.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"cancel_in_progress_transfers": schema.BoolAttribute{
+				Description: "If true, deleting the link cancels snapshot transfers that are still in progress. Otherwise the deletion fails while a transfer is running.",
+				Optional:    true,
+			},
+			"direction":      schema.StringAttribute{Description: "The direction of replication, `outbound` or `inbound`.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"link_type":      schema.StringAttribute{Description: "The type of the replica link.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"status":         schema.StringAttribute{Description: "The status of the link, e.g. `replicating`, `idle` or `unhealthy`.", Computed: true},
+			"status_details": schema.StringAttribute{Description: "Details about the status of the link when it is unhealthy.", Computed: true},
+			"lag":            schema.Int64Attribute{Description: "How far the remote file system is behind the local one, in milliseconds.", Computed: true},
+			"recovery_point": schema.Int64Attribute{Description: "Creation timestamp of the last replicated snapshot, i.e. the state the remote file system would have if it was promoted now.", Computed: true},
+		},
+	}
+}
+
+// Map FB API file system replica link to resource model
+func mapFileSystemReplicaLinkToModel(link *fb.FileSystemReplicaLink, model *fileSystemReplicaLinkResourceModel) {
+	model.ID = types.StringPointerValue(link.Id)
+	model.Direction = types.StringPointerValue(link.Direction)
+	model.LinkType = types.StringPointerValue(link.LinkType)
+	model.Status = types.StringPointerValue(link.Status)
+	model.StatusDetails = types.StringPointerValue(link.StatusDetails)
+	model.Lag = types.Int64PointerValue(link.Lag)
+	model.RecoveryPoint = types.Int64PointerValue(link.RecoveryPoint)
+	if link.LocalFileSystem != nil {
+		model.LocalFileSystem = types.StringPointerValue(link.LocalFileSystem.Name)
+	}
+	if link.Remote != nil {
+		model.Remote = types.StringPointerValue(link.Remote.Name)
+	}
+	if link.RemoteFileSystem != nil {
+		model.RemoteFileSystem = types.StringPointerValue(link.RemoteFileSystem.Name)
+	}
+
+	var policyNames []string
+	if link.Policies != nil {
+		for _, policy := range *link.Policies {
+			if policy.Name != nil {
+				policyNames = append(policyNames, *policy.Name)
+			}
+		}
+	}
+	model.Policies = stringSetValue(&policyNames)
+}
+
+// isReplicaLinkHealthy reports whether a link is transferring snapshots, or is up to date.
+func isReplicaLinkHealthy(link *fb.FileSystemReplicaLink) bool {
+	return link.Status != nil && (*link.Status == "replicating" || *link.Status == "idle")
+}
+
+// --- CREATE ---
+func (r *fileSystemReplicaLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileSystemReplicaLinkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteFileSystem := plan.LocalFileSystem.ValueString()
+	if !plan.RemoteFileSystem.IsUnknown() && !plan.RemoteFileSystem.IsNull() {
+		remoteFileSystem = plan.RemoteFileSystem.ValueString()
+	}
+	var policyNames []string
+	if !plan.Policies.IsNull() {
+		resp.Diagnostics.Append(plan.Policies.ElementsAs(ctx, &policyNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	link, err := r.client.CreateFileSystemReplicaLink(ctx, plan.LocalFileSystem.ValueString(), plan.Remote.ValueString(), remoteFileSystem, policyNames)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating File System Replica Link", "Could not create file system replica link: "+err.Error())
+		return
+	}
+
+	linkID := types.StringPointerValue(link.Id).ValueString()
+	tflog.Debug(ctx, "Waiting for file system replica link to become healthy...", map[string]interface{}{"id": linkID})
+	err = waitFor(ctx, replicaLinkHealthyTimeout, func() (bool, string, error) {
+		current, err := r.client.GetFileSystemReplicaLinkByID(ctx, linkID)
+		if err != nil {
+			return false, "", err
+		}
+		if current == nil {
+			return false, "", fmt.Errorf("replica link %s disappeared", linkID)
+		}
+		link = current
+		return isReplicaLinkHealthy(current), fmt.Sprintf("%s %s", types.StringPointerValue(current.Status).ValueString(), types.StringPointerValue(current.StatusDetails).ValueString()), nil
+	})
+
+	// The link exists either way, so record it. If it never became healthy, Terraform taints it.
+	mapFileSystemReplicaLinkToModel(link, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err != nil {
+		resp.Diagnostics.AddError("File System Replica Link Not Healthy",
+			fmt.Sprintf("Replica link from %s to %s:%s was created but did not become healthy: %s. Check the array connection to %s.",
+				plan.LocalFileSystem.ValueString(), plan.Remote.ValueString(), plan.RemoteFileSystem.ValueString(), err.Error(), plan.Remote.ValueString()))
+	}
+}
+
+// --- READ ---
+func (r *fileSystemReplicaLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileSystemReplicaLinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := r.client.GetFileSystemReplicaLinkByID(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading File System Replica Link", fmt.Sprintf("Could not read file system replica link %s: %s", state.ID.ValueString(), err.Error()))
+		return
+	}
+	if link == nil {
+		tflog.Warn(ctx, "File system replica link not found, removing from state.", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapFileSystemReplicaLinkToModel(link, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Only the attached policies can change in place; they are attached and detached one by one.
+func (r *fileSystemReplicaLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state fileSystemReplicaLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plannedNames, currentNames []string
+	if !plan.Policies.IsNull() {
+		resp.Diagnostics.Append(plan.Policies.ElementsAs(ctx, &plannedNames, false)...)
+	}
+	if !state.Policies.IsNull() {
+		resp.Diagnostics.Append(state.Policies.ElementsAs(ctx, &currentNames, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localFileSystem, remote := state.LocalFileSystem.ValueString(), state.Remote.ValueString()
+	for _, name := range plannedNames {
+		if slices.Contains(currentNames, name) {
+			continue
+		}
+		if err := r.client.AddFileSystemReplicaLinkPolicy(ctx, localFileSystem, remote, name); err != nil {
+			resp.Diagnostics.AddError("Error Attaching Replication Policy", fmt.Sprintf("Could not attach policy %s to the replica link of file system %s: %s", name, localFileSystem, err.Error()))
+			return
+		}
+	}
+	for _, name := range currentNames {
+		if slices.Contains(plannedNames, name) {
+			continue
+		}
+		if err := r.client.RemoveFileSystemReplicaLinkPolicy(ctx, localFileSystem, remote, name); err != nil {
+			resp.Diagnostics.AddError("Error Detaching Replication Policy", fmt.Sprintf("Could not detach policy %s from the replica link of file system %s: %s", name, localFileSystem, err.Error()))
+			return
+		}
+	}
+
+	link, err := r.client.GetFileSystemReplicaLinkByID(ctx, state.ID.ValueString())
+	if err != nil || link == nil {
+		resp.Diagnostics.AddError("Error Reading File System Replica Link", fmt.Sprintf("Could not read file system replica link %s after updating it: %v", state.ID.ValueString(), err))
+		return
+	}
+
+	mapFileSystemReplicaLinkToModel(link, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *fileSystemReplicaLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fileSystemReplicaLinkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFileSystemReplicaLink(ctx, state.ID.ValueString(), state.CancelInProgressTransfers.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting File System Replica Link", fmt.Sprintf("Could not delete the replica link of file system %s to %s: %s", state.LocalFileSystem.ValueString(), state.Remote.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *fileSystemReplicaLinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Replica links are imported by their ID.
+func (r *fileSystemReplicaLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &fileSystemSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &fileSystemSnapshotResource{}
+	_ resource.ResourceWithImportState = &fileSystemSnapshotResource{}
+)
+
+func NewFileSystemSnapshotResource() resource.Resource {
+	return &fileSystemSnapshotResource{}
+}
+
+type fileSystemSnapshotResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type fileSystemSnapshotResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Source        types.String `tfsdk:"source"`
+	Suffix        types.String `tfsdk:"suffix"`
+	Send          types.Bool   `tfsdk:"send"`
+	Targets       types.Set    `tfsdk:"targets"`
+	PolicyName    types.String `tfsdk:"policy_name"`
+	Created       types.Int64  `tfsdk:"created"`
+	Destroyed     types.Bool   `tfsdk:"destroyed"`
+	TimeRemaining types.Int64  `tfsdk:"time_remaining"`
+}
+
+func (r *fileSystemSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_system_snapshot"
+}
+
+// --- SCHEMA ---
+func (r *fileSystemSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an on-demand snapshot of a Pure Storage FlashBlade file system.",
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{Description: "The name of the snapshot, in the form `<file system>.<suffix>`.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"source": schema.StringAttribute{
+				Description:   "The name of the file system to snapshot.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"suffix": schema.StringAttribute{
+				Description:   "The suffix of the snapshot name. If omitted, the array chooses one.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"send": schema.BoolAttribute{
+				Description:   "If true, the snapshot is also replicated to the targets of the file system's replica links.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"targets": schema.SetAttribute{
+				Description:   "The remote arrays to send the snapshot to. Only valid with `send`; defaults to every replica link target.",
+				Optional:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+			},
+			"policy_name":    schema.StringAttribute{Description: "The snapshot policy that created the snapshot, if any.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"created":        schema.Int64Attribute{Description: "Creation timestamp of the snapshot.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+			"destroyed":      schema.BoolAttribute{Description: "Is the snapshot destroyed?", Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}},
+			"time_remaining": schema.Int64Attribute{Description: "Time in milliseconds before a destroyed snapshot is eradicated.", Computed: true},
+		},
+	}
+}
+
+// Map FB API file system snapshot to resource model
+func mapFileSystemSnapshotToModel(snap *fb.FileSystemSnapshot, model *fileSystemSnapshotResourceModel) {
+	model.ID = types.StringPointerValue(snap.Id)
+	model.Name = types.StringPointerValue(snap.Name)
+	model.Suffix = types.StringPointerValue(snap.Suffix)
+	model.Created = types.Int64PointerValue(snap.Created)
+	model.Destroyed = types.BoolPointerValue(snap.Destroyed)
+	model.TimeRemaining = types.Int64PointerValue(snap.TimeRemaining)
+	if snap.Source != nil {
+		model.Source = types.StringPointerValue(snap.Source.Name)
+	}
+	if snap.Policy != nil {
+		model.PolicyName = types.StringPointerValue(snap.Policy.Name)
+	} else {
+		model.PolicyName = types.StringNull()
+	}
+}
+
+// --- CREATE ---
+func (r *fileSystemSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fileSystemSnapshotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets, diags := stringsFromSet(ctx, plan.Targets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if targets != nil && !plan.Send.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("targets"), "Invalid Snapshot Configuration", "`targets` can only be set together with `send = true`.")
+		return
+	}
+	var targetNames []string
+	if targets != nil {
+		targetNames = *targets
+	}
+
+	createdSnap, err := r.client.CreateFileSystemSnapshot(ctx, plan.Source.ValueString(), knownStringPointer(plan.Suffix), plan.Send.ValueBool(), targetNames)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating File System Snapshot", "Could not create file system snapshot: "+err.Error())
+		return
+	}
+
+	mapFileSystemSnapshotToModel(createdSnap, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *fileSystemSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fileSystemSnapshotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snap, err := r.client.GetFileSystemSnapshotByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading File System Snapshot", fmt.Sprintf("Could not read file system snapshot %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if snap == nil || (snap.Destroyed != nil && *snap.Destroyed) {
+		tflog.Warn(ctx, "File system snapshot not found or destroyed, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapFileSystemSnapshotToModel(snap, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Every configurable attribute forces replacement, so there is nothing to patch.
+func (r *fileSystemSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan fileSystemSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *fileSystemSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fileSystemSnapshotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapName := state.Name.ValueString()
+
+	snap, err := r.client.GetFileSystemSnapshotByName(ctx, snapName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking File System Snapshot on Delete", fmt.Sprintf("Could not read file system snapshot %s before deletion: %s", snapName, err.Error()))
+		return
+	}
+	if snap == nil {
+		tflog.Warn(ctx, "File system snapshot not found, removing from state.", map[string]interface{}{"name": snapName})
+		return
+	}
+
+	if snap.Destroyed == nil || !*snap.Destroyed {
+		tflog.Debug(ctx, "Step 1: Marking snapshot for destruction...", map[string]interface{}{"name": snapName})
+		shouldDestroy := true
+		_, err = r.client.UpdateFileSystemSnapshot(ctx, snapName, &fb.FileSystemSnapshot{Destroyed: &shouldDestroy})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Marking File System Snapshot For Deletion", fmt.Sprintf("Could not mark file system snapshot %s for deletion: %s", snapName, err.Error()))
+			return
+		}
+	} else {
+		tflog.Debug(ctx, "Snapshot is already marked for destruction. Skipping soft delete step.", map[string]interface{}{"name": snapName})
+	}
+
+	tflog.Debug(ctx, "Step 2: Eradicating the snapshot...", map[string]interface{}{"name": snapName})
+	err = r.client.EradicateFileSystemSnapshot(ctx, snapName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Eradicating File System Snapshot", fmt.Sprintf("Could not eradicate file system snapshot %s: %s", snapName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *fileSystemSnapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *fileSystemSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &groupQuotaResource{}
+	_ resource.ResourceWithConfigure      = &groupQuotaResource{}
+	_ resource.ResourceWithImportState    = &groupQuotaResource{}
+	_ resource.ResourceWithValidateConfig = &groupQuotaResource{}
+)
+
+func NewGroupQuotaResource() resource.Resource {
+	return &groupQuotaResource{}
+}
+
+type groupQuotaResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type groupQuotaResourceModel struct {
+	quotaResourceModel
+	GID       types.Int64  `tfsdk:"gid"`
+	GroupName types.String `tfsdk:"group_name"`
+}
+
+func (r *groupQuotaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_quota"
+}
+
+// --- SCHEMA ---
+func (r *groupQuotaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the quota of a single group on a Pure Storage FlashBlade file system, overriding the file system's `default_group_quota`.",
+		Attributes: map[string]schema.Attribute{
+			"file_system": schema.StringAttribute{
+				Description:   "The name of the file system the quota applies to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"gid": schema.Int64Attribute{
+				Description:   "The group ID the quota applies to. Exactly one of `gid` and `group_name` must be set.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplaceIfConfigured()},
+			},
+			"group_name": schema.StringAttribute{
+				Description:   "The name of the group the quota applies to. Exactly one of `gid` and `group_name` must be set.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplaceIfConfigured()},
+			},
+			"quota": schema.Int64Attribute{
+				Description: "The space the group may use on the file system, in bytes.",
+				Required:    true,
+			},
+			"usage":                     schema.Int64Attribute{Description: "The space currently used by the group on the file system, in bytes.", Computed: true},
+			"file_system_default_quota": schema.Int64Attribute{Description: "The default group quota of the file system, which this quota overrides.", Computed: true},
+		},
+	}
+}
+
+func (r *groupQuotaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config groupQuotaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.GID.IsUnknown() || config.GroupName.IsUnknown() {
+		return
+	}
+	if config.GID.IsNull() == config.GroupName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("gid"), "Invalid Group Quota Configuration", "Exactly one of `gid` and `group_name` must be set.")
+	}
+}
+
+// Map FB API group quota to resource model
+func mapGroupQuotaToModel(q *fb.GroupQuota, model *groupQuotaResourceModel) {
+	mapQuotaToModel(q.FileSystem, q.Quota, q.Usage, q.FileSystemDefaultQuota, &model.quotaResourceModel)
+	if q.Group != nil {
+		model.GID = types.Int64PointerValue(q.Group.Id)
+		model.GroupName = types.StringPointerValue(q.Group.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *groupQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupQuotaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gid, groupName, err := quotaKey(plan.GID, plan.GroupName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Group Quota", "Could not create group quota: "+err.Error())
+		return
+	}
+	createdQuota, err := r.client.CreateGroupQuota(ctx, plan.FileSystem.ValueString(), gid, groupName, plan.Quota.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Group Quota", "Could not create group quota: "+err.Error())
+		return
+	}
+
+	mapGroupQuotaToModel(createdQuota, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *groupQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupQuotaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gid, groupName, err := quotaKey(state.GID, state.GroupName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Group Quota", fmt.Sprintf("Could not read group quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	quota, err := r.client.GetGroupQuota(ctx, state.FileSystem.ValueString(), gid, groupName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Group Quota", fmt.Sprintf("Could not read group quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	if quota == nil {
+		tflog.Warn(ctx, "Group quota not found, removing from state.", map[string]interface{}{"file_system": state.FileSystem.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapGroupQuotaToModel(quota, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *groupQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state groupQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gid, groupName, err := quotaKey(state.GID, state.GroupName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Group Quota", fmt.Sprintf("Could not update group quota: %s", err.Error()))
+		return
+	}
+	updatedQuota, err := r.client.UpdateGroupQuota(ctx, state.FileSystem.ValueString(), gid, groupName, plan.Quota.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Group Quota", fmt.Sprintf("Could not update group quota: %s", err.Error()))
+		return
+	}
+
+	mapGroupQuotaToModel(updatedQuota, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *groupQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupQuotaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gid, groupName, err := quotaKey(state.GID, state.GroupName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Group Quota", fmt.Sprintf("Could not delete group quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	err = r.client.DeleteGroupQuota(ctx, state.FileSystem.ValueString(), gid, groupName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Group Quota", fmt.Sprintf("Could not delete group quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *groupQuotaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Group quotas are imported with an ID of the form "<file_system>/<gid>" or "<file_system>/<group_name>".
+func (r *groupQuotaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fileSystem, group, ok := splitLastSlash(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <file_system>/<gid> or <file_system>/<group_name>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_system"), fileSystem)...)
+	if gid, err := strconv.ParseInt(group, 10, 32); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gid"), gid)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), group)...)
+	}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &keytabResource{}
+	_ resource.ResourceWithConfigure      = &keytabResource{}
+	_ resource.ResourceWithImportState    = &keytabResource{}
+	_ resource.ResourceWithValidateConfig = &keytabResource{}
+)
+
+var keytabEntryAttributeTypes = map[string]attr.Type{
+	"name":            types.StringType,
+	"principal":       types.StringType,
+	"realm":           types.StringType,
+	"encryption_type": types.StringType,
+	"kvno":            types.Int64Type,
+}
+
+func NewKeytabResource() resource.Resource {
+	return &keytabResource{}
+}
+
+type keytabResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type keytabResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Source     types.String `tfsdk:"source"`
+	KeytabFile types.String `tfsdk:"keytab_file"`
+	Keytabs    types.List   `tfsdk:"keytabs"`
+	Download   types.String `tfsdk:"download"`
+}
+
+func (r *keytabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keytab"
+}
+
+// --- SCHEMA ---
+func (r *keytabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Kerberos keytabs a Pure Storage FlashBlade uses for NFS with `krb5`. " +
+			"The keytabs are either generated from an Active Directory computer account, which rotates its keys, or uploaded from an existing keytab file. " +
+			"The array creates one keytab per service principal and encryption type, all sharing `name_prefix`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "The name prefix of the keytabs.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name_prefix": schema.StringAttribute{
+				Description:   "The prefix of the names of the keytabs, which are named `<name_prefix>.<suffix>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"source": schema.StringAttribute{
+				Description:   "The name of the `flashblade_active_directory` configuration to generate the keytabs from. Conflicts with `keytab_file`.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"keytab_file": schema.StringAttribute{
+				Description:   "The base64-encoded contents of a keytab file to upload, e.g. from `filebase64()`. Conflicts with `source`.",
+				Optional:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"keytabs": schema.ListNestedAttribute{
+				Description: "The keytabs that were created, one per service principal and encryption type.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":            schema.StringAttribute{Computed: true},
+						"principal":       schema.StringAttribute{Description: "The service principal the key belongs to.", Computed: true},
+						"realm":           schema.StringAttribute{Description: "The Kerberos realm of the principal.", Computed: true},
+						"encryption_type": schema.StringAttribute{Description: "The encryption type of the key.", Computed: true},
+						"kvno":            schema.Int64Attribute{Description: "The key version number.", Computed: true},
+					},
+				},
+			},
+			"download": schema.StringAttribute{
+				Description: "The base64-encoded contents of a keytab file holding all of the keys, e.g. for `local_sensitive_file` with `content_base64`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *keytabResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config keytabResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.Source.IsUnknown() || config.KeytabFile.IsUnknown() {
+		return
+	}
+	if config.Source.IsNull() == config.KeytabFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Keytab Configuration", "Exactly one of `source` and `keytab_file` must be set.")
+		return
+	}
+	if !config.KeytabFile.IsNull() {
+		if _, err := base64.StdEncoding.DecodeString(config.KeytabFile.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("keytab_file"), "Invalid Keytab File", "`keytab_file` must be base64-encoded: "+err.Error())
+		}
+	}
+}
+
+// Map the FB API keytabs sharing a prefix to resource model. The source is only known for
+// generated keytabs.
+func mapKeytabsToModel(keytabs []fb.Keytab, model *keytabResourceModel) {
+	model.ID = model.NamePrefix
+	items := make([]attr.Value, 0, len(keytabs))
+	for _, k := range keytabs {
+		items = append(items, types.ObjectValueMust(keytabEntryAttributeTypes, map[string]attr.Value{
+			"name":            types.StringPointerValue(k.Name),
+			"principal":       types.StringPointerValue(k.Principal),
+			"realm":           types.StringPointerValue(k.Realm),
+			"encryption_type": types.StringPointerValue(k.EncryptionType),
+			"kvno":            types.Int64PointerValue(k.Kvno),
+		}))
+		if k.Source != nil && k.Source.Name != nil && model.KeytabFile.IsNull() {
+			model.Source = types.StringPointerValue(k.Source.Name)
+		}
+	}
+	model.Keytabs = types.ListValueMust(types.ObjectType{AttrTypes: keytabEntryAttributeTypes}, items)
+}
+
+// download fetches a keytab file with all of the keys of the given keytabs.
+func (r *keytabResource) download(ctx context.Context, keytabs []fb.Keytab, model *keytabResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	names := make([]string, 0, len(keytabs))
+	for _, k := range keytabs {
+		if k.Name != nil {
+			names = append(names, *k.Name)
+		}
+	}
+	file, err := r.client.DownloadKeytabs(ctx, names)
+	if err != nil {
+		diags.AddError("Error Downloading Keytabs", fmt.Sprintf("Could not download the keytabs with prefix %s: %s", model.NamePrefix.ValueString(), err.Error()))
+		return diags
+	}
+	model.Download = types.StringValue(base64.StdEncoding.EncodeToString(file))
+	return diags
+}
+
+// --- CREATE ---
+func (r *keytabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan keytabResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := plan.NamePrefix.ValueString()
+	var keytabs []fb.Keytab
+	var err error
+	if !plan.Source.IsNull() {
+		keytabs, err = r.client.CreateKeytabs(ctx, prefix, plan.Source.ValueString())
+	} else {
+		file, decodeErr := base64.StdEncoding.DecodeString(plan.KeytabFile.ValueString())
+		if decodeErr != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("keytab_file"), "Invalid Keytab File", "`keytab_file` must be base64-encoded: "+decodeErr.Error())
+			return
+		}
+		keytabs, err = r.client.UploadKeytabs(ctx, prefix, file)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Keytabs", "Could not create keytabs: "+err.Error())
+		return
+	}
+
+	mapKeytabsToModel(keytabs, &plan)
+	resp.Diagnostics.Append(r.download(ctx, keytabs, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *keytabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state keytabResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keytabs, err := r.client.ListKeytabsByPrefix(ctx, state.NamePrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Keytabs", fmt.Sprintf("Could not read the keytabs with prefix %s: %s", state.NamePrefix.ValueString(), err.Error()))
+		return
+	}
+	if len(keytabs) == 0 {
+		tflog.Warn(ctx, "Keytabs not found, removing from state.", map[string]interface{}{"name_prefix": state.NamePrefix.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapKeytabsToModel(keytabs, &state)
+	resp.Diagnostics.Append(r.download(ctx, keytabs, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Every attribute that can be configured forces new keytabs, so there is nothing to update.
+func (r *keytabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan keytabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The keytabs are looked up again, in case the array added or removed some since the last read.
+func (r *keytabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state keytabResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := state.NamePrefix.ValueString()
+	keytabs, err := r.client.ListKeytabsByPrefix(ctx, prefix)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Keytabs", fmt.Sprintf("Could not list the keytabs with prefix %s before deletion: %s", prefix, err.Error()))
+		return
+	}
+	names := make([]string, 0, len(keytabs))
+	for _, k := range keytabs {
+		if k.Name != nil {
+			names = append(names, *k.Name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	err = r.client.DeleteKeytabs(ctx, names)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Keytabs", fmt.Sprintf("Could not delete the keytabs with prefix %s: %s", prefix, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *keytabResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Keytabs are imported by their name prefix. The contents of an uploaded keytab file can't be read
+// back, so imported keytabs that were uploaded are replaced with a fresh upload on the next apply.
+func (r *keytabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name_prefix"), req, resp)
+}
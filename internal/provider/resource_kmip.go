@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &kmipResource{}
+	_ resource.ResourceWithConfigure   = &kmipResource{}
+	_ resource.ResourceWithImportState = &kmipResource{}
+)
+
+func NewKmipResource() resource.Resource {
+	return &kmipResource{}
+}
+
+type kmipResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type kmipResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	URIs               types.List   `tfsdk:"uris"`
+	CaCertificate      types.String `tfsdk:"ca_certificate"`
+	CaCertificateGroup types.String `tfsdk:"ca_certificate_group"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *kmipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kmip"
+}
+
+// --- SCHEMA ---
+func (r *kmipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a KMIP key management server a Pure Storage FlashBlade can keep its encryption keys on, e.g. for `flashblade_rapid_data_locking`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the KMIP server.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"uris": schema.ListAttribute{
+				Description: "The URIs of the KMIP server, e.g. `kmip.example.com:5696`, in order of preference.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description:   "The name of the CA certificate used to verify the KMIP server.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ca_certificate_group": schema.StringAttribute{
+				Description:   "The name of the certificate group whose CA certificates are used to verify the KMIP server.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, the connection to the KMIP server is tested after it is created or updated. Each failed check is reported as an error.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Map FB API KMIP server to resource model
+func mapKmipToModel(k *fb.KmipServer, model *kmipResourceModel) {
+	model.ID = types.StringPointerValue(k.Id)
+	model.Name = types.StringPointerValue(k.Name)
+	model.URIs = stringListValue(k.Uris)
+	model.CaCertificate = types.StringNull()
+	if k.CaCertificate != nil {
+		model.CaCertificate = types.StringPointerValue(k.CaCertificate.Name)
+	}
+	model.CaCertificateGroup = types.StringNull()
+	if k.CaCertificateGroup != nil {
+		model.CaCertificateGroup = types.StringPointerValue(k.CaCertificateGroup.Name)
+	}
+}
+
+func kmipFromPlan(ctx context.Context, plan kmipResourceModel) (fb.KmipServer, diag.Diagnostics) {
+	var kmip fb.KmipServer
+	var diags diag.Diagnostics
+	kmip.Uris, diags = stringsFromList(ctx, plan.URIs)
+	if name := knownStringPointer(plan.CaCertificate); name != nil {
+		kmip.CaCertificate = &fb.Reference{Name: name}
+	}
+	if name := knownStringPointer(plan.CaCertificateGroup); name != nil {
+		kmip.CaCertificateGroup = &fb.Reference{Name: name}
+	}
+	return kmip, diags
+}
+
+// test checks the connection to the KMIP server if test_on_apply is set.
+func (r *kmipResource) test(ctx context.Context, model kmipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestKmipServer(ctx, name)
+	if err != nil {
+		diags.AddError("Error Testing KMIP Server", fmt.Sprintf("Could not test KMIP server %s: %s", name, err.Error()))
+		return diags
+	}
+	return testResultDiagnostics("KMIP Server Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *kmipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan kmipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kmipToCreate, diags := kmipFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createdKmip, err := r.client.CreateKmipServer(ctx, plan.Name.ValueString(), &kmipToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating KMIP Server", "Could not create KMIP server: "+err.Error())
+		return
+	}
+
+	mapKmipToModel(createdKmip, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *kmipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state kmipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kmip, err := r.client.GetKmipServerByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading KMIP Server", fmt.Sprintf("Could not read KMIP server %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if kmip == nil {
+		tflog.Warn(ctx, "KMIP server not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapKmipToModel(kmip, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *kmipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan kmipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kmipToUpdate, diags := kmipFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatedKmip, err := r.client.UpdateKmipServer(ctx, plan.Name.ValueString(), &kmipToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating KMIP Server", fmt.Sprintf("Could not update KMIP server: %s", err.Error()))
+		return
+	}
+
+	mapKmipToModel(updatedKmip, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *kmipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state kmipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	// Deleting the server that holds the rapid data locking keys fails with an error that doesn't
+	// say why. Check first, so the user knows to switch rapid data locking to another server.
+	rdl, err := r.client.GetRapidDataLocking(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Rapid Data Locking", fmt.Sprintf("Could not read rapid data locking before deleting KMIP server %s: %s", name, err.Error()))
+		return
+	}
+	if rdl.KmipServer != nil && rdl.KmipServer.Name != nil && *rdl.KmipServer.Name == name {
+		resp.Diagnostics.AddError("KMIP Server Still In Use",
+			fmt.Sprintf("KMIP server %s cannot be deleted while rapid data locking keeps its keys on it. Point `flashblade_rapid_data_locking` at another KMIP server first.", name))
+		return
+	}
+
+	err = r.client.DeleteKmipServer(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting KMIP Server", fmt.Sprintf("Could not delete KMIP server %s: %s", name, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *kmipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *kmipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &legalHoldResource{}
+	_ resource.ResourceWithConfigure   = &legalHoldResource{}
+	_ resource.ResourceWithImportState = &legalHoldResource{}
+)
+
+func NewLegalHoldResource() resource.Resource {
+	return &legalHoldResource{}
+}
+
+type legalHoldResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type legalHoldResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *legalHoldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_legal_hold"
+}
+
+// --- SCHEMA ---
+func (r *legalHoldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade legal hold, which keeps files immutable regardless of their retention. " +
+			"Place paths under the hold with `flashblade_legal_hold_held_entity`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the legal hold.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the legal hold, e.g. the matter it was placed for.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Map FB API legal hold to resource model
+func mapLegalHoldToModel(h *fb.LegalHold, model *legalHoldResourceModel) {
+	model.ID = types.StringPointerValue(h.Id)
+	model.Name = types.StringPointerValue(h.Name)
+	if h.Description != nil && *h.Description != "" {
+		model.Description = types.StringPointerValue(h.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+}
+
+// --- CREATE ---
+func (r *legalHoldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan legalHoldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	holdToCreate := fb.LegalHold{Description: plan.Description.ValueStringPointer()}
+	createdHold, err := r.client.CreateLegalHold(ctx, plan.Name.ValueString(), &holdToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Legal Hold", "Could not create legal hold: "+err.Error())
+		return
+	}
+
+	mapLegalHoldToModel(createdHold, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *legalHoldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state legalHoldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hold, err := r.client.GetLegalHoldByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Legal Hold", fmt.Sprintf("Could not read legal hold %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if hold == nil {
+		tflog.Warn(ctx, "Legal hold not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapLegalHoldToModel(hold, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *legalHoldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan legalHoldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty description clears it when the attribute is removed from the configuration.
+	description := plan.Description.ValueString()
+	updatedHold, err := r.client.UpdateLegalHold(ctx, plan.Name.ValueString(), &fb.LegalHold{Description: &description})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Legal Hold", fmt.Sprintf("Could not update legal hold: %s", err.Error()))
+		return
+	}
+
+	mapLegalHoldToModel(updatedHold, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *legalHoldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state legalHoldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLegalHold(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Legal Hold", fmt.Sprintf("Could not delete legal hold %s: %s. Release all of its held entities first.", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *legalHoldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *legalHoldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
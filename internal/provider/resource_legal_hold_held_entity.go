@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &legalHoldHeldEntityResource{}
+	_ resource.ResourceWithConfigure   = &legalHoldHeldEntityResource{}
+	_ resource.ResourceWithImportState = &legalHoldHeldEntityResource{}
+)
+
+func NewLegalHoldHeldEntityResource() resource.Resource {
+	return &legalHoldHeldEntityResource{}
+}
+
+type legalHoldHeldEntityResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type legalHoldHeldEntityResourceModel struct {
+	LegalHold  types.String `tfsdk:"legal_hold"`
+	FileSystem types.String `tfsdk:"file_system"`
+	Path       types.String `tfsdk:"path"`
+	Recursive  types.Bool   `tfsdk:"recursive"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (r *legalHoldHeldEntityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_legal_hold_held_entity"
+}
+
+// --- SCHEMA ---
+func (r *legalHoldHeldEntityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Places a path of a Pure Storage FlashBlade file system under a legal hold. Destroying this resource releases the path from the hold.",
+		Attributes: map[string]schema.Attribute{
+			"legal_hold": schema.StringAttribute{
+				Description:   "The name of the legal hold.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"file_system": schema.StringAttribute{
+				Description:   "The name of the file system containing the path.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"path": schema.StringAttribute{
+				Description:   "The path within the file system to hold, e.g. `/finance/2024`. Defaults to the root of the file system.",
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("/"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"recursive": schema.BoolAttribute{
+				Description:   "If true, everything below `path` is held as well.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{Description: "The status of the hold on the path, e.g. `applied` or `releasing`.", Computed: true},
+		},
+	}
+}
+
+// Map FB API held entity to resource model
+func mapLegalHoldHeldEntityToModel(e *fb.LegalHoldHeldEntity, model *legalHoldHeldEntityResourceModel) {
+	model.Status = types.StringPointerValue(e.Status)
+	if e.Path != nil {
+		model.Path = types.StringPointerValue(e.Path)
+	}
+	if e.LegalHold != nil {
+		model.LegalHold = types.StringPointerValue(e.LegalHold.Name)
+	}
+	if e.FileSystem != nil {
+		model.FileSystem = types.StringPointerValue(e.FileSystem.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *legalHoldHeldEntityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan legalHoldHeldEntityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.AddLegalHoldHeldEntity(ctx, plan.LegalHold.ValueString(), plan.FileSystem.ValueString(), plan.Path.ValueString(), plan.Recursive.ValueBoolPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Legal Hold", fmt.Sprintf("Could not place %s on file system %s under legal hold %s: %s", plan.Path.ValueString(), plan.FileSystem.ValueString(), plan.LegalHold.ValueString(), err.Error()))
+		return
+	}
+
+	mapLegalHoldHeldEntityToModel(entity, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *legalHoldHeldEntityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state legalHoldHeldEntityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, err := r.client.GetLegalHoldHeldEntity(ctx, state.LegalHold.ValueString(), state.FileSystem.ValueString(), state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Legal Hold Held Entity", fmt.Sprintf("Could not read held entity %s on file system %s: %s", state.Path.ValueString(), state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	if entity == nil || types.StringPointerValue(entity.Status).ValueString() == "released" {
+		tflog.Warn(ctx, "Legal hold held entity not found, removing from state.", map[string]interface{}{"legal_hold": state.LegalHold.ValueString(), "file_system": state.FileSystem.ValueString(), "path": state.Path.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapLegalHoldHeldEntityToModel(entity, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// All attributes force replacement, so there is nothing to update.
+func (r *legalHoldHeldEntityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+// --- DELETE ---
+func (r *legalHoldHeldEntityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state legalHoldHeldEntityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ReleaseLegalHoldHeldEntity(ctx, state.LegalHold.ValueString(), state.FileSystem.ValueString(), state.Path.ValueString(), state.Recursive.ValueBoolPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Releasing Legal Hold", fmt.Sprintf("Could not release %s on file system %s from legal hold %s: %s", state.Path.ValueString(), state.FileSystem.ValueString(), state.LegalHold.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *legalHoldHeldEntityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Held entities are imported with an ID of the form "<legal_hold>,<file_system>,<path>".
+func (r *legalHoldHeldEntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ",", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <legal_hold>,<file_system>,<path>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("legal_hold"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_system"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), parts[2])...)
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &linkAggregationGroupResource{}
+	_ resource.ResourceWithConfigure   = &linkAggregationGroupResource{}
+	_ resource.ResourceWithImportState = &linkAggregationGroupResource{}
+)
+
+func NewLinkAggregationGroupResource() resource.Resource {
+	return &linkAggregationGroupResource{}
+}
+
+type linkAggregationGroupResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type linkAggregationGroupResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Ports      types.Set    `tfsdk:"ports"`
+	LagSpeed   types.Int64  `tfsdk:"lag_speed"`
+	PortSpeed  types.Int64  `tfsdk:"port_speed"`
+	MacAddress types.String `tfsdk:"mac_address"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (r *linkAggregationGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_aggregation_group"
+}
+
+// --- SCHEMA ---
+func (r *linkAggregationGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade link aggregation group, which bonds physical ports for the subnets configured on it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the link aggregation group.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"ports": schema.SetAttribute{
+				Description: "The names of the ports in the group, e.g. `CH1.FM1.ETH1`. Ports are added and removed in place.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"lag_speed":   schema.Int64Attribute{Description: "The combined speed of the ports in the group, in bits per second.", Computed: true},
+			"port_speed":  schema.Int64Attribute{Description: "The speed of each port in the group, in bits per second.", Computed: true},
+			"mac_address": schema.StringAttribute{Description: "The MAC address of the group.", Computed: true},
+			"status":      schema.StringAttribute{Description: "The health of the group, e.g. `healthy`, `degraded` or `unhealthy`.", Computed: true},
+		},
+	}
+}
+
+// Map FB API link aggregation group to resource model
+func mapLinkAggregationGroupToModel(lag *fb.LinkAggregationGroup, model *linkAggregationGroupResourceModel) {
+	model.ID = types.StringPointerValue(lag.Id)
+	model.Name = types.StringPointerValue(lag.Name)
+	model.LagSpeed = types.Int64PointerValue(lag.LagSpeed)
+	model.PortSpeed = types.Int64PointerValue(lag.PortSpeed)
+	model.MacAddress = types.StringPointerValue(lag.MacAddress)
+	model.Status = types.StringPointerValue(lag.Status)
+
+	var ports []string
+	if lag.Ports != nil {
+		for _, p := range *lag.Ports {
+			if p.Name != nil {
+				ports = append(ports, *p.Name)
+			}
+		}
+	}
+	model.Ports = stringSetValue(&ports)
+}
+
+// portReferences converts the configured port names into references.
+func portReferences(ports *[]string) *[]fb.FixedReference {
+	if ports == nil {
+		return nil
+	}
+	refs := make([]fb.FixedReference, 0, len(*ports))
+	for _, name := range *ports {
+		refs = append(refs, fb.FixedReference{Name: &name})
+	}
+	return &refs
+}
+
+// --- CREATE ---
+func (r *linkAggregationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan linkAggregationGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ports, diags := stringsFromSet(ctx, plan.Ports)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createdLag, err := r.client.CreateLinkAggregationGroup(ctx, plan.Name.ValueString(), &fb.LinkAggregationGroup{Ports: portReferences(ports)})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Link Aggregation Group",
+			"Could not create link aggregation group: "+err.Error()+"\n\nCheck that the ports exist and are not already part of another link aggregation group.")
+		return
+	}
+
+	mapLinkAggregationGroupToModel(createdLag, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *linkAggregationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state linkAggregationGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lag, err := r.client.GetLinkAggregationGroupByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Link Aggregation Group", fmt.Sprintf("Could not read link aggregation group %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if lag == nil {
+		tflog.Warn(ctx, "Link aggregation group not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapLinkAggregationGroupToModel(lag, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *linkAggregationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan linkAggregationGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ports, diags := stringsFromSet(ctx, plan.Ports)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	lagToUpdate := fb.PatchApi217LinkAggregationGroupsJSONRequestBody{Ports: portReferences(ports)}
+	updatedLag, err := r.client.UpdateLinkAggregationGroup(ctx, plan.Name.ValueString(), &lagToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Link Aggregation Group", fmt.Sprintf("Could not update link aggregation group: %s", err.Error()))
+		return
+	}
+
+	mapLinkAggregationGroupToModel(updatedLag, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *linkAggregationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state linkAggregationGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lagName := state.Name.ValueString()
+
+	// Like subnets with interfaces, a group with subnets on it can't be deleted. Name them.
+	subnets, err := r.client.ListSubnetsByLinkAggregationGroup(ctx, lagName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Link Aggregation Group Subnets", fmt.Sprintf("Could not list the subnets of link aggregation group %s before deletion: %s", lagName, err.Error()))
+		return
+	}
+	if len(subnets) > 0 {
+		names := make([]string, 0, len(subnets))
+		for _, s := range subnets {
+			names = append(names, types.StringPointerValue(s.Name).ValueString())
+		}
+		resp.Diagnostics.AddError("Link Aggregation Group Still In Use",
+			fmt.Sprintf("Link aggregation group %s cannot be deleted while subnets are configured on it: %s. Delete these subnets or move them to another group first.", lagName, strings.Join(names, ", ")))
+		return
+	}
+
+	err = r.client.DeleteLinkAggregationGroup(ctx, lagName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Link Aggregation Group", fmt.Sprintf("Could not delete link aggregation group %s: %s", lagName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *linkAggregationGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *linkAggregationGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &networkInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &networkInterfaceResource{}
+	_ resource.ResourceWithImportState = &networkInterfaceResource{}
+)
+
+func NewNetworkInterfaceResource() resource.Resource {
+	return &networkInterfaceResource{}
+}
+
+type networkInterfaceResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type networkInterfaceResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Address  types.String `tfsdk:"address"`
+	Services types.Set    `tfsdk:"services"`
+	Subnet   types.String `tfsdk:"subnet"`
+	Server   types.String `tfsdk:"server"`
+	Type     types.String `tfsdk:"type"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Gateway  types.String `tfsdk:"gateway"`
+	Netmask  types.String `tfsdk:"netmask"`
+	Mtu      types.Int64  `tfsdk:"mtu"`
+	Vlan     types.Int64  `tfsdk:"vlan"`
+}
+
+func (r *networkInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_interface"
+}
+
+// --- SCHEMA ---
+func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade virtual network interface (VIP), e.g. a data VIP that clients mount file systems through.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the network interface.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"address": schema.StringAttribute{
+				Description: "The IP address of the interface. It must be within the `prefix` of its subnet.",
+				Required:    true,
+			},
+			"services": schema.SetAttribute{
+				Description:   "The services provided by the interface, e.g. `data`, `replication` or `egress-only`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"subnet": schema.StringAttribute{
+				Description:   "The name of the subnet the interface is created in, e.g. the `name` of a `flashblade_subnet`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"server": schema.StringAttribute{
+				Description:   "The name of the server the interface belongs to. Defaults to the array's default server.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"type":    schema.StringAttribute{Description: "The type of the interface. Interfaces created by Terraform are always `vip`.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"enabled": schema.BoolAttribute{Description: "Whether the interface is enabled.", Computed: true},
+			"gateway": schema.StringAttribute{Description: "The gateway of the interface, taken from its subnet.", Computed: true},
+			"netmask": schema.StringAttribute{Description: "The netmask of the interface, taken from its subnet.", Computed: true},
+			"mtu":     schema.Int64Attribute{Description: "The maximum transmission unit of the interface, taken from its subnet.", Computed: true},
+			"vlan":    schema.Int64Attribute{Description: "The VLAN ID of the interface, taken from its subnet.", Computed: true},
+		},
+	}
+}
+
+// Map FB API network interface to resource model
+func mapNetworkInterfaceToModel(ni *fb.NetworkInterface, model *networkInterfaceResourceModel) {
+	model.ID = types.StringPointerValue(ni.Id)
+	model.Name = types.StringPointerValue(ni.Name)
+	model.Address = types.StringPointerValue(ni.Address)
+	model.Services = stringSetValue(ni.Services)
+	model.Type = types.StringPointerValue(ni.Type)
+	model.Enabled = types.BoolPointerValue(ni.Enabled)
+	model.Gateway = types.StringPointerValue(ni.Gateway)
+	model.Netmask = types.StringPointerValue(ni.Netmask)
+	model.Mtu = int32PointerValue(ni.Mtu)
+	model.Vlan = int32PointerValue(ni.Vlan)
+	if ni.Subnet != nil {
+		model.Subnet = types.StringPointerValue(ni.Subnet.Name)
+	}
+	if ni.Server != nil {
+		model.Server = types.StringPointerValue(ni.Server.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *networkInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan networkInterfaceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, diags := stringsFromSet(ctx, plan.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vip := "vip"
+	niToCreate := fb.NetworkInterface{
+		Address:  plan.Address.ValueStringPointer(),
+		Services: services,
+		Type:     &vip,
+	}
+	niToCreate.Subnet = &struct {
+		Id           *string `json:"id,omitempty"`
+		Name         *string `json:"name,omitempty"`
+		ResourceType *string `json:"resource_type,omitempty"`
+	}{Name: plan.Subnet.ValueStringPointer()}
+	if server := knownStringPointer(plan.Server); server != nil {
+		niToCreate.Server = &fb.Reference{Name: server}
+	}
+	createdNI, err := r.client.CreateNetworkInterface(ctx, plan.Name.ValueString(), &niToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Network Interface",
+			fmt.Sprintf("Could not create network interface: %s\n\nCheck that subnet %s exists and that %s is within its prefix. If the subnet is managed by Terraform, reference its `name` attribute so it is created first.",
+				err.Error(), plan.Subnet.ValueString(), plan.Address.ValueString()))
+		return
+	}
+
+	mapNetworkInterfaceToModel(createdNI, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *networkInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state networkInterfaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ni, err := r.client.GetNetworkInterfaceByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Network Interface", fmt.Sprintf("Could not read network interface %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if ni == nil {
+		tflog.Warn(ctx, "Network interface not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapNetworkInterfaceToModel(ni, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *networkInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, diags := stringsFromSet(ctx, plan.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	niToUpdate := fb.NetworkInterfacePatch{
+		Address:  plan.Address.ValueStringPointer(),
+		Services: services,
+	}
+	if server := knownStringPointer(plan.Server); server != nil {
+		niToUpdate.Server = &fb.Reference{Name: server}
+	}
+	updatedNI, err := r.client.UpdateNetworkInterface(ctx, plan.Name.ValueString(), &niToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Network Interface", fmt.Sprintf("Could not update network interface: %s", err.Error()))
+		return
+	}
+
+	mapNetworkInterfaceToModel(updatedNI, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *networkInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state networkInterfaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNetworkInterface(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Network Interface", fmt.Sprintf("Could not delete network interface %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *networkInterfaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *networkInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &nfsExportPolicyResource{}
+	_ resource.ResourceWithConfigure   = &nfsExportPolicyResource{}
+	_ resource.ResourceWithImportState = &nfsExportPolicyResource{}
+)
+
+func NewNfsExportPolicyResource() resource.Resource {
+	return &nfsExportPolicyResource{}
+}
+
+type nfsExportPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type nfsExportPolicyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	PolicyType types.String `tfsdk:"policy_type"`
+	Version    types.String `tfsdk:"version"`
+}
+
+func (r *nfsExportPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_export_policy"
+}
+
+// --- SCHEMA ---
+func (r *nfsExportPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade NFS export policy. Rules are managed with `flashblade_nfs_export_policy_rule`, " +
+			"and the policy is applied to a file system through the `export_policy_name` attribute of its `nfs` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "Whether the policy is enabled.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"version":     schema.StringAttribute{Description: "A token that changes whenever the policy or its rules are modified.", Computed: true},
+		},
+	}
+}
+
+// Map FB API NFS export policy to resource model
+func mapNfsExportPolicyToModel(p *fb.NfsExportPolicy, model *nfsExportPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+	model.Version = types.StringPointerValue(p.Version)
+}
+
+// --- CREATE ---
+func (r *nfsExportPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan nfsExportPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.NfsExportPolicyPost{}
+	if !plan.Enabled.IsUnknown() {
+		policyToCreate.Enabled = plan.Enabled.ValueBoolPointer()
+	}
+
+	createdPolicy, err := r.client.CreateNfsExportPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating NFS Export Policy", "Could not create NFS export policy: "+err.Error())
+		return
+	}
+
+	mapNfsExportPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *nfsExportPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nfsExportPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetNfsExportPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading NFS Export Policy", fmt.Sprintf("Could not read NFS export policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "NFS export policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapNfsExportPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *nfsExportPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state nfsExportPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Enabled.Equal(state.Enabled) {
+		tflog.Debug(ctx, "No changes detected for NFS export policy, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	updatedPolicy, err := r.client.UpdateNfsExportPolicy(ctx, plan.Name.ValueString(), &fb.NfsExportPolicy{Enabled: plan.Enabled.ValueBoolPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating NFS Export Policy", fmt.Sprintf("Could not update NFS export policy: %s", err.Error()))
+		return
+	}
+
+	mapNfsExportPolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *nfsExportPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state nfsExportPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyName := state.Name.ValueString()
+	err := r.client.DeleteNfsExportPolicy(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting NFS Export Policy", fmt.Sprintf("Could not delete NFS export policy %s: %s", policyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *nfsExportPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *nfsExportPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
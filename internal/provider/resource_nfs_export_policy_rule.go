@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &nfsExportPolicyRuleResource{}
+	_ resource.ResourceWithConfigure   = &nfsExportPolicyRuleResource{}
+	_ resource.ResourceWithImportState = &nfsExportPolicyRuleResource{}
+)
+
+func NewNfsExportPolicyRuleResource() resource.Resource {
+	return &nfsExportPolicyRuleResource{}
+}
+
+type nfsExportPolicyRuleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type nfsExportPolicyRuleResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Policy     types.String `tfsdk:"policy"`
+	Name       types.String `tfsdk:"name"`
+	Index      types.Int64  `tfsdk:"index"`
+	Client     types.String `tfsdk:"client"`
+	Access     types.String `tfsdk:"access"`
+	Permission types.String `tfsdk:"permission"`
+	Anonuid    types.String `tfsdk:"anonuid"`
+	Anongid    types.String `tfsdk:"anongid"`
+	Security   types.Set    `tfsdk:"security"`
+	Atime      types.Bool   `tfsdk:"atime"`
+}
+
+func (r *nfsExportPolicyRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_export_policy_rule"
+}
+
+// --- SCHEMA ---
+func (r *nfsExportPolicyRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a rule of a Pure Storage FlashBlade NFS export policy. Rules are evaluated in order of their index.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"policy": schema.StringAttribute{
+				Description:   "The name of the NFS export policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{Description: "The name of the rule, chosen by the array.", Computed: true},
+			"index": schema.Int64Attribute{
+				Description: "The position of the rule in the policy, starting at 1. If omitted, the rule is appended to the end of the policy. " +
+					"The index may be at most one past the last of the other rules, which places the rule last.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"client": schema.StringAttribute{
+				Description:   "The clients the rule applies to, as a hostname, IP address, CIDR block or netgroup. Defaults to `*`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"access": schema.StringAttribute{
+				Description:   "How client users are mapped. Can be `root-squash`, `all-squash` or `no-squash`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"permission": schema.StringAttribute{
+				Description:   "The access granted to matching clients. Can be `rw` or `ro`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"anonuid": schema.StringAttribute{
+				Description:   "The user ID that squashed users are mapped to.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"anongid": schema.StringAttribute{
+				Description:   "The group ID that squashed users are mapped to.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"security": schema.SetAttribute{
+				Description:   "The security flavors clients may use, e.g. `sys`, `krb5`, `krb5i` and `krb5p`.",
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"atime": schema.BoolAttribute{
+				Description:   "Whether access times are updated when files are read.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Map FB API NFS export policy rule to resource model
+func mapNfsExportPolicyRuleToModel(rule *fb.NfsExportPolicyRule, model *nfsExportPolicyRuleResourceModel) {
+	model.ID = types.StringPointerValue(rule.Id)
+	model.Name = types.StringPointerValue(rule.Name)
+	model.Client = types.StringPointerValue(rule.Client)
+	model.Access = types.StringPointerValue(rule.Access)
+	model.Permission = types.StringPointerValue(rule.Permission)
+	model.Anonuid = types.StringPointerValue(rule.Anonuid)
+	model.Anongid = types.StringPointerValue(rule.Anongid)
+	model.Security = stringSetValue(rule.Security)
+	model.Atime = types.BoolPointerValue(rule.Atime)
+	if rule.Index != nil {
+		model.Index = types.Int64Value(int64(*rule.Index))
+	} else {
+		model.Index = types.Int64Null()
+	}
+	if rule.Policy != nil {
+		model.Policy = types.StringPointerValue(rule.Policy.Name)
+	}
+}
+
+// nfsExportRuleRequest builds the request body for a rule. Attributes left unset are omitted so
+// the array applies its defaults.
+func nfsExportRuleRequest(ctx context.Context, plan *nfsExportPolicyRuleResourceModel) (*fb.NfsExportPolicyRule, diag.Diagnostics) {
+	rule := &fb.NfsExportPolicyRule{
+		Client:     knownStringPointer(plan.Client),
+		Access:     knownStringPointer(plan.Access),
+		Permission: knownStringPointer(plan.Permission),
+		Anonuid:    knownStringPointer(plan.Anonuid),
+		Anongid:    knownStringPointer(plan.Anongid),
+		Atime:      knownBoolPointer(plan.Atime),
+	}
+	security, diags := stringsFromSet(ctx, plan.Security)
+	rule.Security = security
+	return rule, diags
+}
+
+// placeRule looks up where a rule must be placed to end up at index.
+func (r *nfsExportPolicyRuleResource) placeRule(ctx context.Context, policy, id string, index int64) (rulePlacement, error) {
+	rules, err := r.client.ListNfsExportPolicyRules(ctx, policy)
+	if err != nil {
+		return rulePlacement{}, err
+	}
+	refs := make([]policyRuleRef, 0, len(rules))
+	for _, rule := range rules {
+		refs = append(refs, policyRuleRef{ID: rule.Id, Name: rule.Name})
+	}
+	return placeRule(refs, id, index)
+}
+
+// --- CREATE ---
+func (r *nfsExportPolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan nfsExportPolicyRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToCreate, diags := nfsExportRuleRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var before *string
+	if !plan.Index.IsUnknown() && !plan.Index.IsNull() {
+		placement, err := r.placeRule(ctx, plan.Policy.ValueString(), "", plan.Index.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("index"), "Error Creating NFS Export Policy Rule", "Could not determine the position of the rule: "+err.Error())
+			return
+		}
+		before = placement.Before
+	}
+
+	createdRule, err := r.client.CreateNfsExportPolicyRule(ctx, plan.Policy.ValueString(), before, ruleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating NFS Export Policy Rule", "Could not create NFS export policy rule: "+err.Error())
+		return
+	}
+
+	mapNfsExportPolicyRuleToModel(createdRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *nfsExportPolicyRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nfsExportPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetNfsExportPolicyRuleByID(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading NFS Export Policy Rule", fmt.Sprintf("Could not read rule %s of NFS export policy %s: %s", state.ID.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+	if rule == nil {
+		tflog.Warn(ctx, "NFS export policy rule not found, removing from state.", map[string]interface{}{"policy": state.Policy.ValueString(), "id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapNfsExportPolicyRuleToModel(rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *nfsExportPolicyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state nfsExportPolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToUpdate, diags := nfsExportRuleRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var before *string
+	var lastRule *policyRuleRef
+	if !plan.Index.IsUnknown() && !plan.Index.IsNull() && !plan.Index.Equal(state.Index) {
+		placement, err := r.placeRule(ctx, plan.Policy.ValueString(), state.ID.ValueString(), plan.Index.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("index"), "Error Updating NFS Export Policy Rule", "Could not determine the position of the rule: "+err.Error())
+			return
+		}
+		before = placement.Before
+		if before == nil && placement.Last != nil && placement.Last.ID != nil {
+			before, lastRule = placement.Last.Name, placement.Last
+		}
+	}
+
+	updatedRule, err := r.client.UpdateNfsExportPolicyRule(ctx, state.ID.ValueString(), before, ruleToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating NFS Export Policy Rule", fmt.Sprintf("Could not update NFS export policy rule: %s", err.Error()))
+		return
+	}
+	if lastRule != nil {
+		// The rule now sits in front of the last rule, which is moved in front of it to make the rule last.
+		if _, err := r.client.UpdateNfsExportPolicyRule(ctx, *lastRule.ID, updatedRule.Name, &fb.NfsExportPolicyRule{}); err != nil {
+			resp.Diagnostics.AddError("Error Updating NFS Export Policy Rule", fmt.Sprintf("Could not move the rule to the end of NFS export policy %s: %s", plan.Policy.ValueString(), err.Error()))
+			return
+		}
+		updatedRule, err = r.client.GetNfsExportPolicyRuleByID(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading NFS Export Policy Rule", fmt.Sprintf("Could not read rule %s of NFS export policy %s after moving it: %s", state.ID.ValueString(), plan.Policy.ValueString(), err.Error()))
+			return
+		}
+		if updatedRule == nil {
+			resp.Diagnostics.AddError("Error Reading NFS Export Policy Rule", fmt.Sprintf("Rule %s of NFS export policy %s no longer exists.", state.ID.ValueString(), plan.Policy.ValueString()))
+			return
+		}
+	}
+
+	mapNfsExportPolicyRuleToModel(updatedRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *nfsExportPolicyRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state nfsExportPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNfsExportPolicyRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting NFS Export Policy Rule", fmt.Sprintf("Could not delete rule %s of NFS export policy %s: %s", state.Name.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *nfsExportPolicyRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Rules are imported by their ID, since rule names follow their position in the policy.
+func (r *nfsExportPolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &objectStoreAccessKeyResource{}
+	_ resource.ResourceWithConfigure      = &objectStoreAccessKeyResource{}
+	_ resource.ResourceWithImportState    = &objectStoreAccessKeyResource{}
+	_ resource.ResourceWithValidateConfig = &objectStoreAccessKeyResource{}
+)
+
+func NewObjectStoreAccessKeyResource() resource.Resource {
+	return &objectStoreAccessKeyResource{}
+}
+
+type objectStoreAccessKeyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreAccessKeyResourceModel struct {
+	Name                     types.String `tfsdk:"name"`
+	User                     types.String `tfsdk:"user"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	SecretAccessKey          types.String `tfsdk:"secret_access_key"`
+	SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+	Created                  types.Int64  `tfsdk:"created"`
+}
+
+func (r *objectStoreAccessKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_access_key"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreAccessKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an access key for a Pure Storage FlashBlade object store user. " +
+			"The secret access key is only returned by the array when the key is created. To keep it out of the state " +
+			"file entirely, import an existing key pair by supplying `name` and the secret through `secret_access_key_wo`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:   "The access key ID. Generated by the array unless an existing key pair is imported with `secret_access_key_wo`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"user": schema.StringAttribute{
+				Description:   "The name of the object store user the key belongs to, in the form `<account>/<user>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "Whether the access key is enabled.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Description:   "The secret access key generated by the array. Only known after the key is created; `null` for imported keys or when `secret_access_key_wo` is used.",
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"secret_access_key_wo": schema.StringAttribute{
+				Description: "A secret access key to import together with `name`, for example one replicated from another FlashBlade. " +
+					"This value is write-only and is never stored in the state.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"secret_access_key_wo_version": schema.Int64Attribute{
+				Description:   "Change this value to re-import the key pair with a new `secret_access_key_wo`.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"created": schema.Int64Attribute{Description: "Creation timestamp of the access key.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+func (r *objectStoreAccessKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config objectStoreAccessKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.SecretAccessKeyWO.IsNull() && config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Access Key Name", "`name` must be set when importing a key pair with `secret_access_key_wo`.")
+	}
+}
+
+// Map FB API access key to resource model. The secret is deliberately left alone, because the
+// API only returns it in the create response.
+func mapObjectStoreAccessKeyToModel(k *fb.ObjectStoreAccessKey, model *objectStoreAccessKeyResourceModel) {
+	model.Name = types.StringPointerValue(k.Name)
+	model.Enabled = types.BoolPointerValue(k.Enabled)
+	model.Created = types.Int64PointerValue(k.Created)
+	if k.User != nil {
+		model.User = types.StringPointerValue(k.User.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *objectStoreAccessKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreAccessKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration, never in the plan.
+	var secretWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_access_key_wo"), &secretWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keyName *string
+	if !plan.Name.IsUnknown() && !plan.Name.IsNull() {
+		keyName = plan.Name.ValueStringPointer()
+	}
+	createdKey, err := r.client.CreateObjectStoreAccessKey(ctx, plan.User.ValueString(), keyName, secretWO.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Object Store Access Key", "Could not create object store access key: "+err.Error())
+		return
+	}
+
+	if secretWO.IsNull() {
+		plan.SecretAccessKey = types.StringPointerValue(createdKey.SecretAccessKey)
+	} else {
+		plan.SecretAccessKey = types.StringNull()
+	}
+
+	if !plan.Enabled.IsUnknown() && !plan.Enabled.IsNull() && plan.Enabled.ValueBool() != types.BoolPointerValue(createdKey.Enabled).ValueBool() {
+		updatedKey, err := r.client.UpdateObjectStoreAccessKey(ctx, *createdKey.Name, &fb.ObjectStoreAccessKey{Enabled: plan.Enabled.ValueBoolPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Setting Access Key State", fmt.Sprintf("Access key %s was created but could not be enabled or disabled: %s", *createdKey.Name, err.Error()))
+			return
+		}
+		createdKey.Enabled = updatedKey.Enabled
+	}
+
+	mapObjectStoreAccessKeyToModel(createdKey, &plan)
+	plan.SecretAccessKeyWO = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreAccessKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreAccessKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetObjectStoreAccessKeyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Object Store Access Key", fmt.Sprintf("Could not read object store access key %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if key == nil {
+		tflog.Warn(ctx, "Object store access key not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreAccessKeyToModel(key, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *objectStoreAccessKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state objectStoreAccessKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Enabled.Equal(state.Enabled) {
+		tflog.Debug(ctx, "No changes detected for object store access key, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	keyName := state.Name.ValueString()
+	updatedKey, err := r.client.UpdateObjectStoreAccessKey(ctx, keyName, &fb.ObjectStoreAccessKey{Enabled: plan.Enabled.ValueBoolPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Object Store Access Key", fmt.Sprintf("Could not update object store access key %s: %s", keyName, err.Error()))
+		return
+	}
+
+	mapObjectStoreAccessKeyToModel(updatedKey, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreAccessKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreAccessKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyName := state.Name.ValueString()
+	err := r.client.DeleteObjectStoreAccessKey(ctx, keyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Object Store Access Key", fmt.Sprintf("Could not delete object store access key %s: %s", keyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreAccessKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *objectStoreAccessKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &objectStoreAccessPolicyResource{}
+	_ resource.ResourceWithConfigure   = &objectStoreAccessPolicyResource{}
+	_ resource.ResourceWithImportState = &objectStoreAccessPolicyResource{}
+)
+
+func NewObjectStoreAccessPolicyResource() resource.Resource {
+	return &objectStoreAccessPolicyResource{}
+}
+
+type objectStoreAccessPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreAccessPolicyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Account     types.String `tfsdk:"account"`
+	Arn         types.String `tfsdk:"arn"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	PolicyType  types.String `tfsdk:"policy_type"`
+	Created     types.Int64  `tfsdk:"created"`
+}
+
+func (r *objectStoreAccessPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_access_policy"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreAccessPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade object store access policy. Rules are managed with `flashblade_object_store_access_policy_rule`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the policy, in the form `<account>/<policy>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"description": schema.StringAttribute{
+				Description:   "A description of the policy. Changing this forces a new policy.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"account":     schema.StringAttribute{Description: "The object store account the policy belongs to.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"arn":         schema.StringAttribute{Description: "The Amazon Resource Name (ARN) of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"enabled":     schema.BoolAttribute{Description: "Whether the policy is enabled.", Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"created":     schema.Int64Attribute{Description: "Creation timestamp of the policy.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API access policy to resource model
+func mapObjectStoreAccessPolicyToModel(p *fb.ObjectStoreAccessPolicy, model *objectStoreAccessPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Arn = types.StringPointerValue(p.Arn)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+	model.Created = types.Int64PointerValue(p.Created)
+	if p.Description != nil && *p.Description != "" {
+		model.Description = types.StringPointerValue(p.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	if p.Account != nil {
+		model.Account = types.StringPointerValue(p.Account.Name)
+	} else {
+		model.Account = types.StringNull()
+	}
+}
+
+// --- CREATE ---
+func (r *objectStoreAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreAccessPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.ObjectStoreAccessPolicyPost{Description: plan.Description.ValueStringPointer()}
+	createdPolicy, err := r.client.CreateObjectStoreAccessPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Object Store Access Policy", "Could not create object store access policy: "+err.Error())
+		return
+	}
+
+	mapObjectStoreAccessPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreAccessPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetObjectStoreAccessPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Object Store Access Policy", fmt.Sprintf("Could not read object store access policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "Object store access policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreAccessPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Every configurable attribute forces replacement, so there is nothing to patch.
+func (r *objectStoreAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan objectStoreAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreAccessPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyName := state.Name.ValueString()
+	err := r.client.DeleteObjectStoreAccessPolicy(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Object Store Access Policy", fmt.Sprintf("Could not delete object store access policy %s: %s", policyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreAccessPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *objectStoreAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
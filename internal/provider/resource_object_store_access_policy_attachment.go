@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+func NewObjectStoreAccessPolicyUserAttachmentResource() resource.Resource {
+	return &policyAttachmentResource{policyAttachmentKind: policyAttachmentKind{
+		typeName:          "object_store_access_policy_user_attachment",
+		description:       "Attaches a Pure Storage FlashBlade object store access policy to an object store user.",
+		policyTitle:       "Access Policy",
+		policyLabel:       "access policy",
+		policyDescription: "The name of the access policy, e.g. `<account>/<policy>` or `pure:policy/full-access`.",
+		memberAttribute:   "user",
+		memberLabel:       "user",
+		memberDescription: "The name of the object store user, in the form `<account>/<user>`.",
+		add: func(ctx context.Context, c *client.Client, policy, member string) error {
+			_, err := c.AddObjectStoreAccessPolicyUser(ctx, policy, member)
+			return err
+		},
+		exists: func(ctx context.Context, c *client.Client, policy, member string) (bool, error) {
+			membership, err := c.GetObjectStoreAccessPolicyUser(ctx, policy, member)
+			return membership != nil, err
+		},
+		remove: func(ctx context.Context, c *client.Client, policy, member string) error {
+			return c.RemoveObjectStoreAccessPolicyUser(ctx, policy, member)
+		},
+	}}
+}
+
+func NewObjectStoreAccessPolicyRoleAttachmentResource() resource.Resource {
+	return &policyAttachmentResource{policyAttachmentKind: policyAttachmentKind{
+		typeName:          "object_store_access_policy_role_attachment",
+		description:       "Attaches a Pure Storage FlashBlade object store access policy to an object store role.",
+		policyTitle:       "Access Policy",
+		policyLabel:       "access policy",
+		policyDescription: "The name of the access policy, e.g. `<account>/<policy>` or `pure:policy/full-access`.",
+		memberAttribute:   "role",
+		memberLabel:       "role",
+		memberDescription: "The name of the object store role, in the form `<account>/<role>`.",
+		add: func(ctx context.Context, c *client.Client, policy, member string) error {
+			_, err := c.AddObjectStoreAccessPolicyRole(ctx, policy, member)
+			return err
+		},
+		exists: func(ctx context.Context, c *client.Client, policy, member string) (bool, error) {
+			membership, err := c.GetObjectStoreAccessPolicyRole(ctx, policy, member)
+			return membership != nil, err
+		},
+		remove: func(ctx context.Context, c *client.Client, policy, member string) error {
+			return c.RemoveObjectStoreAccessPolicyRole(ctx, policy, member)
+		},
+	}}
+}
@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	pathpkg "path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &objectStoreAccessPolicyRuleResource{}
+	_ resource.ResourceWithConfigure      = &objectStoreAccessPolicyRuleResource{}
+	_ resource.ResourceWithImportState    = &objectStoreAccessPolicyRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &objectStoreAccessPolicyRuleResource{}
+	_ resource.ResourceWithValidateConfig = &objectStoreAccessPolicyRuleResource{}
+)
+
+var objectAccessConditionsAttributeTypes = map[string]attr.Type{
+	"source_ips":    types.SetType{ElemType: types.StringType},
+	"s3_prefixes":   types.SetType{ElemType: types.StringType},
+	"s3_delimiters": types.SetType{ElemType: types.StringType},
+}
+
+func NewObjectStoreAccessPolicyRuleResource() resource.Resource {
+	return &objectStoreAccessPolicyRuleResource{}
+}
+
+type objectStoreAccessPolicyRuleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreAccessPolicyRuleResourceModel struct {
+	Policy     types.String `tfsdk:"policy"`
+	Name       types.String `tfsdk:"name"`
+	Effect     types.String `tfsdk:"effect"`
+	Actions    types.Set    `tfsdk:"actions"`
+	Resources  types.Set    `tfsdk:"resources"`
+	Conditions types.Object `tfsdk:"conditions"`
+}
+
+type objectAccessConditionsModel struct {
+	SourceIps    types.Set `tfsdk:"source_ips"`
+	S3Prefixes   types.Set `tfsdk:"s3_prefixes"`
+	S3Delimiters types.Set `tfsdk:"s3_delimiters"`
+}
+
+func (r *objectStoreAccessPolicyRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_access_policy_rule"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreAccessPolicyRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a rule of a Pure Storage FlashBlade object store access policy.",
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{
+				Description:   "The name of the access policy, in the form `<account>/<policy>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "The name of the rule.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"effect": schema.StringAttribute{
+				Description:   "Effect of the rule when it matches. Can be `allow` or `deny`. Defaults to `allow`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"actions": schema.SetAttribute{
+				Description: "The S3 actions the rule applies to, e.g. `s3:GetObject`. Wildcards such as `s3:Get*` are allowed. Actions are checked against the array at plan time.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"resources": schema.SetAttribute{
+				Description: "The resources the rule applies to, e.g. `my-bucket/*`.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"conditions": schema.SingleNestedAttribute{
+				Description: "Conditions that must be met for the rule to apply. Conditions that don't apply are left out rather than set to an empty set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"source_ips":    schema.SetAttribute{Description: "IP addresses or CIDR blocks the request must come from.", Optional: true, ElementType: types.StringType},
+					"s3_prefixes":   schema.SetAttribute{Description: "Object key prefixes the request must be limited to.", Optional: true, ElementType: types.StringType},
+					"s3_delimiters": schema.SetAttribute{Description: "Delimiters the request must use.", Optional: true, ElementType: types.StringType},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects empty conditions. The array doesn't keep an empty condition, so it would
+// read back as unset and never match the configuration.
+func (r *objectStoreAccessPolicyRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config objectStoreAccessPolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Conditions.IsNull() || config.Conditions.IsUnknown() {
+		return
+	}
+	var conditions objectAccessConditionsModel
+	resp.Diagnostics.Append(config.Conditions.As(ctx, &conditions, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sets := map[string]types.Set{
+		"source_ips":    conditions.SourceIps,
+		"s3_prefixes":   conditions.S3Prefixes,
+		"s3_delimiters": conditions.S3Delimiters,
+	}
+	configured := false
+	for attribute, set := range sets {
+		if set.IsNull() {
+			continue
+		}
+		configured = true
+		if !set.IsUnknown() && len(set.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("conditions").AtName(attribute), "Empty Condition",
+				fmt.Sprintf("`%s` must not be empty. Leave it out to not restrict the rule by it.", attribute))
+		}
+	}
+	if !configured {
+		resp.Diagnostics.AddAttributeError(path.Root("conditions"), "Empty Conditions",
+			"`conditions` must set at least one condition. Leave it out to not restrict the rule.")
+	}
+}
+
+// ModifyPlan checks the planned actions against the list of actions supported by the array, so a
+// typo is reported during plan instead of failing the apply.
+func (r *objectStoreAccessPolicyRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var actions types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("actions"), &actions)...)
+	if resp.Diagnostics.HasError() || actions.IsUnknown() || actions.IsNull() {
+		return
+	}
+	planned, diags := stringsFromSet(ctx, actions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	supported, err := r.client.ListObjectStoreAccessPolicyActions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Validate Actions", "Could not list the actions supported by the array, so `actions` will only be validated on apply: "+err.Error())
+		return
+	}
+
+	for _, action := range *planned {
+		if !objectAccessActionSupported(action, supported) {
+			resp.Diagnostics.AddAttributeError(path.Root("actions"), "Unsupported Action",
+				fmt.Sprintf("%q does not match any action supported by the array. Supported actions are: %s.", action, strings.Join(supported, ", ")))
+		}
+	}
+}
+
+func objectAccessActionSupported(action string, supported []string) bool {
+	for _, s := range supported {
+		if s == action {
+			return true
+		}
+		if strings.Contains(action, "*") {
+			if ok, _ := pathpkg.Match(action, s); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Map FB API rule to resource model
+func mapObjectStoreAccessPolicyRuleToModel(rule *fb.PolicyRuleObjectAccess, model *objectStoreAccessPolicyRuleResourceModel) {
+	model.Name = types.StringPointerValue(rule.Name)
+	model.Effect = types.StringPointerValue(rule.Effect)
+	model.Actions = stringSetValue(rule.Actions)
+	model.Resources = stringSetValue(rule.Resources)
+	if rule.Policy != nil {
+		model.Policy = types.StringPointerValue(rule.Policy.Name)
+	}
+
+	c := rule.Conditions
+	if c == nil || ((c.SourceIps == nil || len(*c.SourceIps) == 0) && (c.S3Prefixes == nil || len(*c.S3Prefixes) == 0) && (c.S3Delimiters == nil || len(*c.S3Delimiters) == 0)) {
+		model.Conditions = types.ObjectNull(objectAccessConditionsAttributeTypes)
+		return
+	}
+	model.Conditions = basetypes.NewObjectValueMust(objectAccessConditionsAttributeTypes, map[string]attr.Value{
+		"source_ips":    stringSetValue(c.SourceIps),
+		"s3_prefixes":   stringSetValue(c.S3Prefixes),
+		"s3_delimiters": stringSetValue(c.S3Delimiters),
+	})
+}
+
+// objectAccessRuleRequest builds the request body for a rule. Conditions are always sent, so
+// removing them from the configuration clears them on the array.
+func objectAccessRuleRequest(ctx context.Context, plan *objectStoreAccessPolicyRuleResourceModel) (*fb.PolicyRuleObjectAccessPost, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rule := &fb.PolicyRuleObjectAccessPost{
+		Effect:     knownStringPointer(plan.Effect),
+		Conditions: &fb.PolicyRuleObjectAccessCondition{SourceIps: &[]string{}, S3Prefixes: &[]string{}, S3Delimiters: &[]string{}},
+	}
+
+	var d diag.Diagnostics
+	rule.Actions, d = stringsFromSet(ctx, plan.Actions)
+	diags.Append(d...)
+	rule.Resources, d = stringsFromSet(ctx, plan.Resources)
+	diags.Append(d...)
+
+	if !plan.Conditions.IsNull() && !plan.Conditions.IsUnknown() {
+		var conditions objectAccessConditionsModel
+		diags.Append(plan.Conditions.As(ctx, &conditions, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if v, d := stringsFromSet(ctx, conditions.SourceIps); v != nil {
+			diags.Append(d...)
+			rule.Conditions.SourceIps = v
+		}
+		if v, d := stringsFromSet(ctx, conditions.S3Prefixes); v != nil {
+			diags.Append(d...)
+			rule.Conditions.S3Prefixes = v
+		}
+		if v, d := stringsFromSet(ctx, conditions.S3Delimiters); v != nil {
+			diags.Append(d...)
+			rule.Conditions.S3Delimiters = v
+		}
+	}
+	return rule, diags
+}
+
+// --- CREATE ---
+func (r *objectStoreAccessPolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreAccessPolicyRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToCreate, diags := objectAccessRuleRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdRule, err := r.client.CreateObjectStoreAccessPolicyRule(ctx, plan.Policy.ValueString(), plan.Name.ValueString(), ruleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Access Policy Rule", "Could not create object store access policy rule: "+err.Error())
+		return
+	}
+
+	mapObjectStoreAccessPolicyRuleToModel(createdRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreAccessPolicyRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreAccessPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetObjectStoreAccessPolicyRule(ctx, state.Policy.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Access Policy Rule", fmt.Sprintf("Could not read rule %s of policy %s: %s", state.Name.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+	if rule == nil {
+		tflog.Warn(ctx, "Access policy rule not found, removing from state.", map[string]interface{}{"policy": state.Policy.ValueString(), "name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreAccessPolicyRuleToModel(rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *objectStoreAccessPolicyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan objectStoreAccessPolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToUpdate, diags := objectAccessRuleRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedRule, err := r.client.UpdateObjectStoreAccessPolicyRule(ctx, plan.Policy.ValueString(), plan.Name.ValueString(), ruleToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Access Policy Rule", fmt.Sprintf("Could not update access policy rule: %s", err.Error()))
+		return
+	}
+
+	mapObjectStoreAccessPolicyRuleToModel(updatedRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreAccessPolicyRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreAccessPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteObjectStoreAccessPolicyRule(ctx, state.Policy.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Access Policy Rule", fmt.Sprintf("Could not delete rule %s of policy %s: %s", state.Name.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreAccessPolicyRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Rules are imported with an ID of the form "<account>/<policy>/<rule>".
+func (r *objectStoreAccessPolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	policy, name, ok := splitLastSlash(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <account>/<policy>/<rule>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy"), policy)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
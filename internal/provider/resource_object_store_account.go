@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &objectStoreAccountResource{}
+	_ resource.ResourceWithConfigure   = &objectStoreAccountResource{}
+	_ resource.ResourceWithImportState = &objectStoreAccountResource{}
+)
+
+var bucketDefaultsAttributeTypes = map[string]attr.Type{
+	"quota_limit":        types.Int64Type,
+	"hard_limit_enabled": types.BoolType,
+}
+
+var publicAccessConfigAttributeTypes = map[string]attr.Type{
+	"block_new_public_policies": types.BoolType,
+	"block_public_access":       types.BoolType,
+}
+
+func NewObjectStoreAccountResource() resource.Resource {
+	return &objectStoreAccountResource{}
+}
+
+type objectStoreAccountResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreAccountResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	QuotaLimit         types.Int64  `tfsdk:"quota_limit"`
+	HardLimitEnabled   types.Bool   `tfsdk:"hard_limit_enabled"`
+	BucketDefaults     types.Object `tfsdk:"bucket_defaults"`
+	PublicAccessConfig types.Object `tfsdk:"public_access_config"`
+	ObjectCount        types.Int64  `tfsdk:"object_count"`
+	Created            types.Int64  `tfsdk:"created"`
+}
+
+type bucketDefaultsModel struct {
+	QuotaLimit       types.Int64 `tfsdk:"quota_limit"`
+	HardLimitEnabled types.Bool  `tfsdk:"hard_limit_enabled"`
+}
+
+type publicAccessConfigModel struct {
+	BlockNewPublicPolicies types.Bool `tfsdk:"block_new_public_policies"`
+	BlockPublicAccess      types.Bool `tfsdk:"block_public_access"`
+}
+
+func (r *objectStoreAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_account"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade object store account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the object store account.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"quota_limit": schema.Int64Attribute{
+				Description:   "The quota limit applied against the total size of all buckets in the account, in bytes. If unset, the account is unlimited in size.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"hard_limit_enabled": schema.BoolAttribute{
+				Description:   "If set to true, the account's `quota_limit` is used as a hard limit quota.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"bucket_defaults": schema.SingleNestedAttribute{
+				Description:   "Default settings applied to new buckets created in this account.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"quota_limit":        schema.Int64Attribute{Description: "The default quota limit for new buckets, in bytes.", Optional: true, Computed: true},
+					"hard_limit_enabled": schema.BoolAttribute{Description: "The default `hard_limit_enabled` for new buckets.", Optional: true, Computed: true},
+				},
+			},
+			"public_access_config": schema.SingleNestedAttribute{
+				Description:   "Public access settings for the account.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"block_new_public_policies": schema.BoolAttribute{Description: "If true, adding bucket policies which grant public access is blocked.", Optional: true, Computed: true},
+					"block_public_access":       schema.BoolAttribute{Description: "If true, access to buckets with public policies is restricted to authorized users of this account.", Optional: true, Computed: true},
+				},
+			},
+			"object_count": schema.Int64Attribute{Description: "The count of objects within the account.", Computed: true},
+			"created":      schema.Int64Attribute{Description: "Creation timestamp of the account.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API object store account to resource model
+func mapObjectStoreAccountToModel(a *fb.ObjectStoreAccount, model *objectStoreAccountResourceModel) {
+	model.ID = types.StringPointerValue(a.Id)
+	model.Name = types.StringPointerValue(a.Name)
+	model.QuotaLimit = intPointerValue(a.QuotaLimit)
+	model.HardLimitEnabled = types.BoolPointerValue(a.HardLimitEnabled)
+	model.ObjectCount = types.Int64PointerValue(a.ObjectCount)
+	model.Created = types.Int64PointerValue(a.Created)
+
+	if a.BucketDefaults != nil {
+		model.BucketDefaults = basetypes.NewObjectValueMust(bucketDefaultsAttributeTypes, map[string]attr.Value{
+			"quota_limit":        intPointerValue(a.BucketDefaults.QuotaLimit),
+			"hard_limit_enabled": types.BoolPointerValue(a.BucketDefaults.HardLimitEnabled),
+		})
+	} else {
+		model.BucketDefaults = types.ObjectNull(bucketDefaultsAttributeTypes)
+	}
+
+	if a.PublicAccessConfig != nil {
+		model.PublicAccessConfig = basetypes.NewObjectValueMust(publicAccessConfigAttributeTypes, map[string]attr.Value{
+			"block_new_public_policies": types.BoolPointerValue(a.PublicAccessConfig.BlockNewPublicPolicies),
+			"block_public_access":       types.BoolPointerValue(a.PublicAccessConfig.BlockPublicAccess),
+		})
+	} else {
+		model.PublicAccessConfig = types.ObjectNull(publicAccessConfigAttributeTypes)
+	}
+}
+
+func bucketDefaultsRequest(ctx context.Context, obj types.Object) (*fb.BucketDefaults, error) {
+	var data bucketDefaultsModel
+	if diags := obj.As(ctx, &data, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return nil, fmt.Errorf("invalid bucket_defaults")
+	}
+	defaults := &fb.BucketDefaults{HardLimitEnabled: knownBoolPointer(data.HardLimitEnabled)}
+	if !data.QuotaLimit.IsUnknown() {
+		defaults.QuotaLimit = quotaLimitString(data.QuotaLimit)
+	}
+	return defaults, nil
+}
+
+func publicAccessConfigRequest(ctx context.Context, obj types.Object) (*fb.PublicAccessConfig, error) {
+	var data publicAccessConfigModel
+	if diags := obj.As(ctx, &data, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true}); diags.HasError() {
+		return nil, fmt.Errorf("invalid public_access_config")
+	}
+	return &fb.PublicAccessConfig{
+		BlockNewPublicPolicies: knownBoolPointer(data.BlockNewPublicPolicies),
+		BlockPublicAccess:      knownBoolPointer(data.BlockPublicAccess),
+	}, nil
+}
+
+// --- CREATE ---
+func (r *objectStoreAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreAccountResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountToCreate := fb.ObjectStoreAccountPost{
+		HardLimitEnabled: knownBoolPointer(plan.HardLimitEnabled),
+	}
+	if !plan.QuotaLimit.IsUnknown() && !plan.QuotaLimit.IsNull() {
+		accountToCreate.QuotaLimit = quotaLimitString(plan.QuotaLimit)
+	}
+	if !plan.BucketDefaults.IsUnknown() && !plan.BucketDefaults.IsNull() {
+		defaults, err := bucketDefaultsRequest(ctx, plan.BucketDefaults)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("bucket_defaults"), "Invalid Bucket Defaults", err.Error())
+			return
+		}
+		accountToCreate.BucketDefaults = defaults
+	}
+
+	accountName := plan.Name.ValueString()
+	createdAccount, err := r.client.CreateObjectStoreAccount(ctx, accountName, &accountToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Object Store Account", "Could not create object store account: "+err.Error())
+		return
+	}
+
+	// The public access settings are not part of ObjectStoreAccountPost, so they have to be applied with a follow-up patch.
+	if !plan.PublicAccessConfig.IsUnknown() && !plan.PublicAccessConfig.IsNull() {
+		publicAccess, err := publicAccessConfigRequest(ctx, plan.PublicAccessConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("public_access_config"), "Invalid Public Access Configuration", err.Error())
+			return
+		}
+		createdAccount, err = r.client.UpdateObjectStoreAccount(ctx, accountName, &fb.ObjectStoreAccountPatch{PublicAccessConfig: publicAccess})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Setting Public Access Configuration", fmt.Sprintf("Object store account %s was created but its public access configuration could not be set: %s", accountName, err.Error()))
+			return
+		}
+	}
+
+	mapObjectStoreAccountToModel(createdAccount, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreAccountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.client.GetObjectStoreAccountByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Object Store Account", fmt.Sprintf("Could not read object store account %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if account == nil {
+		tflog.Warn(ctx, "Object store account not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreAccountToModel(account, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *objectStoreAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state objectStoreAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountToUpdate := fb.ObjectStoreAccountPatch{}
+	isPatchNeeded := false
+
+	if !plan.QuotaLimit.Equal(state.QuotaLimit) {
+		isPatchNeeded = true
+		accountToUpdate.QuotaLimit = quotaLimitString(plan.QuotaLimit)
+	}
+	if !plan.HardLimitEnabled.Equal(state.HardLimitEnabled) {
+		isPatchNeeded = true
+		accountToUpdate.HardLimitEnabled = knownBoolPointer(plan.HardLimitEnabled)
+	}
+	if !plan.BucketDefaults.IsUnknown() && !plan.BucketDefaults.IsNull() && !plan.BucketDefaults.Equal(state.BucketDefaults) {
+		defaults, err := bucketDefaultsRequest(ctx, plan.BucketDefaults)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("bucket_defaults"), "Invalid Bucket Defaults", err.Error())
+			return
+		}
+		isPatchNeeded = true
+		accountToUpdate.BucketDefaults = defaults
+	}
+	if !plan.PublicAccessConfig.IsUnknown() && !plan.PublicAccessConfig.IsNull() && !plan.PublicAccessConfig.Equal(state.PublicAccessConfig) {
+		publicAccess, err := publicAccessConfigRequest(ctx, plan.PublicAccessConfig)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("public_access_config"), "Invalid Public Access Configuration", err.Error())
+			return
+		}
+		isPatchNeeded = true
+		accountToUpdate.PublicAccessConfig = publicAccess
+	}
+
+	if !isPatchNeeded {
+		// Values the array computes may still have changed, so read the object store account instead of patching it.
+		tflog.Debug(ctx, "No changes detected for object store account, reading it instead of updating it.")
+		account, err := r.client.GetObjectStoreAccountByName(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Object Store Account", fmt.Sprintf("Could not read object store account %s: %s", plan.Name.ValueString(), err.Error()))
+			return
+		}
+		if account == nil {
+			resp.Diagnostics.AddError("Error Reading Object Store Account", fmt.Sprintf("Object store account %s no longer exists.", plan.Name.ValueString()))
+			return
+		}
+		mapObjectStoreAccountToModel(account, &plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	updatedAccount, err := r.client.UpdateObjectStoreAccount(ctx, plan.Name.ValueString(), &accountToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Object Store Account", fmt.Sprintf("Could not update object store account: %s", err.Error()))
+		return
+	}
+
+	mapObjectStoreAccountToModel(updatedAccount, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreAccountResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountName := state.Name.ValueString()
+	err := r.client.DeleteObjectStoreAccount(ctx, accountName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Object Store Account", fmt.Sprintf("Could not delete object store account %s: %s", accountName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *objectStoreAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
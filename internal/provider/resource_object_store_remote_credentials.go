@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &objectStoreRemoteCredentialsResource{}
+	_ resource.ResourceWithConfigure   = &objectStoreRemoteCredentialsResource{}
+	_ resource.ResourceWithImportState = &objectStoreRemoteCredentialsResource{}
+)
+
+func NewObjectStoreRemoteCredentialsResource() resource.Resource {
+	return &objectStoreRemoteCredentialsResource{}
+}
+
+type objectStoreRemoteCredentialsResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreRemoteCredentialsResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	AccessKeyIDWO      types.String `tfsdk:"access_key_id_wo"`
+	SecretAccessKeyWO  types.String `tfsdk:"secret_access_key_wo"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	Remote             types.String `tfsdk:"remote"`
+}
+
+func (r *objectStoreRemoteCredentialsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_remote_credentials"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreRemoteCredentialsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the credentials a Pure Storage FlashBlade uses to access buckets on a remote FlashBlade or S3 target, e.g. for `flashblade_bucket_replica_link`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the credentials, in the form `<remote>/<name>`, where `<remote>` is a connected array or a `flashblade_target`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"access_key_id_wo": schema.StringAttribute{
+				Description: "The access key ID used to connect to the remote object store. This value is write-only and is never stored in the state.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"secret_access_key_wo": schema.StringAttribute{
+				Description: "The secret access key used to connect to the remote object store. This value is write-only and is never stored in the state.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Change this value to update the credentials in place with new `access_key_id_wo` and `secret_access_key_wo` values.",
+				Optional:    true,
+			},
+			"remote": schema.StringAttribute{Description: "The name of the remote array or target the credentials belong to.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API remote credentials to resource model. The keys are deliberately left alone, because
+// they are write-only.
+func mapObjectStoreRemoteCredentialsToModel(creds *fb.ObjectStoreRemoteCredentials, model *objectStoreRemoteCredentialsResourceModel) {
+	model.ID = types.StringPointerValue(creds.Id)
+	model.Name = types.StringPointerValue(creds.Name)
+	if creds.Remote != nil {
+		model.Remote = types.StringPointerValue(creds.Remote.Name)
+	}
+}
+
+// configuredKeys reads the write-only key pair, which is only available in the configuration.
+func (r *objectStoreRemoteCredentialsResource) configuredKeys(ctx context.Context, config tfsdk.Config) (types.String, types.String, diag.Diagnostics) {
+	var accessKeyID, secretAccessKey types.String
+	var diags diag.Diagnostics
+	diags.Append(config.GetAttribute(ctx, path.Root("access_key_id_wo"), &accessKeyID)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_access_key_wo"), &secretAccessKey)...)
+	return accessKeyID, secretAccessKey, diags
+}
+
+// --- CREATE ---
+func (r *objectStoreRemoteCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreRemoteCredentialsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessKeyID, secretAccessKey, diags := r.configuredKeys(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credsToCreate := fb.ObjectStoreRemoteCredentialsPost{
+		AccessKeyId:     accessKeyID.ValueStringPointer(),
+		SecretAccessKey: secretAccessKey.ValueStringPointer(),
+	}
+	createdCreds, err := r.client.CreateObjectStoreRemoteCredentials(ctx, plan.Name.ValueString(), &credsToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Object Store Remote Credentials", "Could not create object store remote credentials: "+err.Error())
+		return
+	}
+
+	mapObjectStoreRemoteCredentialsToModel(createdCreds, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreRemoteCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreRemoteCredentialsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds, err := r.client.GetObjectStoreRemoteCredentialsByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Object Store Remote Credentials", fmt.Sprintf("Could not read object store remote credentials %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if creds == nil {
+		tflog.Warn(ctx, "Object store remote credentials not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreRemoteCredentialsToModel(creds, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Write-only values never show up in a plan diff, so the keys are only sent again when
+// credentials_wo_version changes.
+func (r *objectStoreRemoteCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state objectStoreRemoteCredentialsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CredentialsVersion.Equal(state.CredentialsVersion) {
+		tflog.Debug(ctx, "No changes detected for object store remote credentials, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	accessKeyID, secretAccessKey, diags := r.configuredKeys(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credsToUpdate := fb.ObjectStoreRemoteCredentials{
+		AccessKeyId:     accessKeyID.ValueStringPointer(),
+		SecretAccessKey: secretAccessKey.ValueStringPointer(),
+	}
+	updatedCreds, err := r.client.UpdateObjectStoreRemoteCredentials(ctx, state.Name.ValueString(), &credsToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Object Store Remote Credentials", fmt.Sprintf("Could not update object store remote credentials: %s", err.Error()))
+		return
+	}
+
+	mapObjectStoreRemoteCredentialsToModel(updatedCreds, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreRemoteCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreRemoteCredentialsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteObjectStoreRemoteCredentials(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Object Store Remote Credentials", fmt.Sprintf("Could not delete object store remote credentials %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreRemoteCredentialsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Imported credentials keep their keys until credentials_wo_version is changed.
+func (r *objectStoreRemoteCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
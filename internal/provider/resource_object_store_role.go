@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &objectStoreRoleResource{}
+	_ resource.ResourceWithConfigure   = &objectStoreRoleResource{}
+	_ resource.ResourceWithImportState = &objectStoreRoleResource{}
+)
+
+func NewObjectStoreRoleResource() resource.Resource {
+	return &objectStoreRoleResource{}
+}
+
+type objectStoreRoleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreRoleResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Account            types.String `tfsdk:"account"`
+	MaxSessionDuration types.Int64  `tfsdk:"max_session_duration"`
+	Prn                types.String `tfsdk:"prn"`
+	Created            types.Int64  `tfsdk:"created"`
+}
+
+func (r *objectStoreRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_role"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade object store role that can be assumed through STS. " +
+			"Who may assume the role is controlled with `flashblade_object_store_role_trust_policy_rule`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the role, in the form `<account>/<role>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"account": schema.StringAttribute{Description: "The object store account the role belongs to.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"max_session_duration": schema.Int64Attribute{
+				Description:   "The maximum session duration for the role, in milliseconds.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"prn":     schema.StringAttribute{Description: "The Pure Resource Name of the role, used as the role ARN in STS requests.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"created": schema.Int64Attribute{Description: "Creation timestamp of the role.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API role to resource model
+func mapObjectStoreRoleToModel(role *fb.ObjectStoreRole, model *objectStoreRoleResourceModel) {
+	model.ID = types.StringPointerValue(role.Id)
+	model.Name = types.StringPointerValue(role.Name)
+	model.MaxSessionDuration = intPointerValue(role.MaxSessionDuration)
+	model.Prn = types.StringPointerValue(role.Prn)
+	model.Created = types.Int64PointerValue(role.Created)
+	if role.Account != nil {
+		model.Account = types.StringPointerValue(role.Account.Name)
+	} else {
+		model.Account = types.StringNull()
+	}
+}
+
+// --- CREATE ---
+func (r *objectStoreRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleToCreate := fb.ObjectStoreRolePost{}
+	if !plan.MaxSessionDuration.IsUnknown() && !plan.MaxSessionDuration.IsNull() {
+		duration := int(plan.MaxSessionDuration.ValueInt64())
+		roleToCreate.MaxSessionDuration = &duration
+	}
+
+	createdRole, err := r.client.CreateObjectStoreRole(ctx, plan.Name.ValueString(), &roleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Object Store Role", "Could not create object store role: "+err.Error())
+		return
+	}
+
+	mapObjectStoreRoleToModel(createdRole, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.GetObjectStoreRoleByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Object Store Role", fmt.Sprintf("Could not read object store role %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if role == nil {
+		tflog.Warn(ctx, "Object store role not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreRoleToModel(role, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *objectStoreRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state objectStoreRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MaxSessionDuration.Equal(state.MaxSessionDuration) {
+		tflog.Debug(ctx, "No changes detected for object store role, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	duration := int(plan.MaxSessionDuration.ValueInt64())
+	updatedRole, err := r.client.UpdateObjectStoreRole(ctx, plan.Name.ValueString(), &fb.ObjectStoreRole{MaxSessionDuration: &duration})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Object Store Role", fmt.Sprintf("Could not update object store role: %s", err.Error()))
+		return
+	}
+
+	mapObjectStoreRoleToModel(updatedRole, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.Name.ValueString()
+	err := r.client.DeleteObjectStoreRole(ctx, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Object Store Role", fmt.Sprintf("Could not delete object store role %s: %s", roleName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *objectStoreRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &objectStoreRoleTrustPolicyRuleResource{}
+	_ resource.ResourceWithConfigure   = &objectStoreRoleTrustPolicyRuleResource{}
+	_ resource.ResourceWithImportState = &objectStoreRoleTrustPolicyRuleResource{}
+)
+
+var trustPolicyConditionAttributeTypes = map[string]attr.Type{
+	"key":      types.StringType,
+	"operator": types.StringType,
+	"values":   types.SetType{ElemType: types.StringType},
+}
+
+func NewObjectStoreRoleTrustPolicyRuleResource() resource.Resource {
+	return &objectStoreRoleTrustPolicyRuleResource{}
+}
+
+type objectStoreRoleTrustPolicyRuleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreRoleTrustPolicyRuleResourceModel struct {
+	Role       types.String `tfsdk:"role"`
+	Name       types.String `tfsdk:"name"`
+	Index      types.Int64  `tfsdk:"index"`
+	Effect     types.String `tfsdk:"effect"`
+	Actions    types.Set    `tfsdk:"actions"`
+	Principals types.Set    `tfsdk:"principals"`
+	Conditions types.List   `tfsdk:"conditions"`
+}
+
+type trustPolicyConditionModel struct {
+	Key      types.String `tfsdk:"key"`
+	Operator types.String `tfsdk:"operator"`
+	Values   types.Set    `tfsdk:"values"`
+}
+
+func (r *objectStoreRoleTrustPolicyRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_role_trust_policy_rule"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreRoleTrustPolicyRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a rule of the trust policy of a Pure Storage FlashBlade object store role, controlling which identity providers may assume the role.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description:   "The name of the role, in the form `<account>/<role>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "The name of the rule. If omitted, the array chooses one.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"index":  schema.Int64Attribute{Description: "The position of the rule within the trust policy.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+			"effect": schema.StringAttribute{Description: "Effect of the rule when it matches. Only `allow` is supported.", Optional: true, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"actions": schema.SetAttribute{
+				Description: "The STS actions the rule grants, e.g. `sts:AssumeRoleWithSAML` or `sts:AssumeRoleWithWebIdentity`.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"principals": schema.SetAttribute{
+				Description: "The names of the identity providers trusted to assume the role.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"conditions": schema.ListNestedAttribute{
+				Description: "Conditions on the claims of the assertion or token that must be met for the rule to apply.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key":      schema.StringAttribute{Description: "The claim the condition is evaluated against, e.g. `saml:sub`.", Required: true},
+						"operator": schema.StringAttribute{Description: "The condition operator, e.g. `StringEquals`.", Required: true},
+						"values":   schema.SetAttribute{Description: "The values the claim is compared against.", Required: true, ElementType: types.StringType},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Map FB API trust policy rule to resource model
+func mapTrustPolicyRuleToModel(rule *fb.TrustPolicyRuleWithContext, model *objectStoreRoleTrustPolicyRuleResourceModel) {
+	model.Name = types.StringPointerValue(rule.Name)
+	model.Index = intPointerValue(rule.Index)
+	model.Effect = types.StringPointerValue(rule.Effect)
+	model.Actions = stringSetValue(rule.Actions)
+
+	var principals []string
+	if rule.Principals != nil {
+		for _, p := range *rule.Principals {
+			if p.Name != nil {
+				principals = append(principals, *p.Name)
+			}
+		}
+	}
+	model.Principals = stringSetValue(&principals)
+
+	if rule.Conditions == nil || len(*rule.Conditions) == 0 {
+		model.Conditions = types.ListNull(types.ObjectType{AttrTypes: trustPolicyConditionAttributeTypes})
+		return
+	}
+	conditions := make([]attr.Value, 0, len(*rule.Conditions))
+	for _, c := range *rule.Conditions {
+		conditions = append(conditions, basetypes.NewObjectValueMust(trustPolicyConditionAttributeTypes, map[string]attr.Value{
+			"key":      types.StringPointerValue(c.Key),
+			"operator": types.StringPointerValue(c.Operator),
+			"values":   stringSetValue(c.Values),
+		}))
+	}
+	model.Conditions = types.ListValueMust(types.ObjectType{AttrTypes: trustPolicyConditionAttributeTypes}, conditions)
+}
+
+// trustPolicyRuleRequest builds the request body for a rule. Conditions are always sent, so
+// removing them from the configuration clears them on the array.
+func trustPolicyRuleRequest(ctx context.Context, plan *objectStoreRoleTrustPolicyRuleResourceModel) (*fb.TrustPolicyRulePost, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rule := &fb.TrustPolicyRulePost{
+		Conditions: &[]fb.TrustPolicyRuleCondition{},
+	}
+	if !plan.Effect.IsUnknown() {
+		rule.Effect = plan.Effect.ValueStringPointer()
+	}
+
+	var d diag.Diagnostics
+	rule.Actions, d = stringsFromSet(ctx, plan.Actions)
+	diags.Append(d...)
+
+	principals, d := stringsFromSet(ctx, plan.Principals)
+	diags.Append(d...)
+	if principals != nil {
+		refs := make([]fb.ReferenceWritable, 0, len(*principals))
+		for _, name := range *principals {
+			refs = append(refs, fb.ReferenceWritable{Name: &name})
+		}
+		rule.Principals = &refs
+	}
+
+	if !plan.Conditions.IsNull() && !plan.Conditions.IsUnknown() {
+		var conditions []trustPolicyConditionModel
+		diags.Append(plan.Conditions.ElementsAs(ctx, &conditions, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for _, c := range conditions {
+			values, d := stringsFromSet(ctx, c.Values)
+			diags.Append(d...)
+			*rule.Conditions = append(*rule.Conditions, fb.TrustPolicyRuleCondition{
+				Key:      c.Key.ValueStringPointer(),
+				Operator: c.Operator.ValueStringPointer(),
+				Values:   values,
+			})
+		}
+	}
+	return rule, diags
+}
+
+// --- CREATE ---
+func (r *objectStoreRoleTrustPolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreRoleTrustPolicyRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToCreate, diags := trustPolicyRuleRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleName *string
+	if !plan.Name.IsUnknown() && !plan.Name.IsNull() {
+		ruleName = plan.Name.ValueStringPointer()
+	}
+
+	createdRule, err := r.client.CreateTrustPolicyRule(ctx, plan.Role.ValueString(), ruleName, ruleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Trust Policy Rule", "Could not create object store role trust policy rule: "+err.Error())
+		return
+	}
+
+	mapTrustPolicyRuleToModel(createdRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreRoleTrustPolicyRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreRoleTrustPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetTrustPolicyRule(ctx, state.Role.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Trust Policy Rule", fmt.Sprintf("Could not read trust policy rule %s of role %s: %s", state.Name.ValueString(), state.Role.ValueString(), err.Error()))
+		return
+	}
+	if rule == nil {
+		tflog.Warn(ctx, "Trust policy rule not found, removing from state.", map[string]interface{}{"role": state.Role.ValueString(), "name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapTrustPolicyRuleToModel(rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *objectStoreRoleTrustPolicyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan objectStoreRoleTrustPolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToUpdate, diags := trustPolicyRuleRequest(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedRule, err := r.client.UpdateTrustPolicyRule(ctx, plan.Role.ValueString(), plan.Name.ValueString(), ruleToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Trust Policy Rule", fmt.Sprintf("Could not update trust policy rule: %s", err.Error()))
+		return
+	}
+
+	mapTrustPolicyRuleToModel(updatedRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreRoleTrustPolicyRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreRoleTrustPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTrustPolicyRule(ctx, state.Role.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Trust Policy Rule", fmt.Sprintf("Could not delete trust policy rule %s of role %s: %s", state.Name.ValueString(), state.Role.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreRoleTrustPolicyRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Rules are imported with an ID of the form "<account>/<role>/<rule>".
+func (r *objectStoreRoleTrustPolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	role, name, ok := splitLastSlash(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <account>/<role>/<rule>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &objectStoreUserResource{}
+	_ resource.ResourceWithConfigure   = &objectStoreUserResource{}
+	_ resource.ResourceWithImportState = &objectStoreUserResource{}
+)
+
+func NewObjectStoreUserResource() resource.Resource {
+	return &objectStoreUserResource{}
+}
+
+type objectStoreUserResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type objectStoreUserResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Account    types.String `tfsdk:"account"`
+	FullAccess types.Bool   `tfsdk:"full_access"`
+	Created    types.Int64  `tfsdk:"created"`
+}
+
+func (r *objectStoreUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_store_user"
+}
+
+// --- SCHEMA ---
+func (r *objectStoreUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade object store (S3) user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the user, in the form `<account>/<user>`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"account": schema.StringAttribute{
+				Description:   "The object store account the user belongs to.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"full_access": schema.BoolAttribute{
+				Description:   "If true, the user is granted the `pure:policy/full-access` policy when it is created. Changing this forces a new user.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"created": schema.Int64Attribute{Description: "Creation timestamp of the user.", Computed: true, PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API object store user to resource model
+func mapObjectStoreUserToModel(u *fb.ObjectStoreUser, model *objectStoreUserResourceModel) {
+	model.ID = types.StringPointerValue(u.Id)
+	model.Name = types.StringPointerValue(u.Name)
+	model.Created = types.Int64PointerValue(u.Created)
+	if u.Account != nil {
+		model.Account = types.StringPointerValue(u.Account.Name)
+	} else {
+		model.Account = types.StringNull()
+	}
+}
+
+// --- CREATE ---
+func (r *objectStoreUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectStoreUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdUser, err := r.client.CreateObjectStoreUser(ctx, plan.Name.ValueString(), plan.FullAccess.ValueBoolPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Object Store User", "Could not create object store user: "+err.Error())
+		return
+	}
+
+	mapObjectStoreUserToModel(createdUser, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *objectStoreUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectStoreUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetObjectStoreUserByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Object Store User", fmt.Sprintf("Could not read object store user %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if user == nil {
+		tflog.Warn(ctx, "Object store user not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapObjectStoreUserToModel(user, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Every configurable attribute forces replacement, so there is nothing to patch.
+func (r *objectStoreUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan objectStoreUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *objectStoreUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectStoreUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userName := state.Name.ValueString()
+	err := r.client.DeleteObjectStoreUser(ctx, userName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Object Store User", fmt.Sprintf("Could not delete object store user %s: %s", userName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *objectStoreUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *objectStoreUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &policyAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &policyAttachmentResource{}
+	_ resource.ResourceWithImportState = &policyAttachmentResource{}
+)
+
+// policyAttachmentKind describes one kind of policy membership, such as an access policy applied to
+// an object store user. Every kind has a "policy" attribute and one member attribute, both of which
+// force replacement.
+type policyAttachmentKind struct {
+	// typeName is the resource type name without the provider prefix.
+	typeName    string
+	description string
+	// policyTitle names the policy in diagnostic summaries, e.g. "Access Policy"; policyLabel names
+	// it in sentences, e.g. "access policy".
+	policyTitle       string
+	policyLabel       string
+	policyDescription string
+	// memberAttribute names the member attribute and the second half of the import ID.
+	memberAttribute   string
+	memberLabel       string
+	memberDescription string
+
+	add    func(ctx context.Context, c *client.Client, policy, member string) error
+	exists func(ctx context.Context, c *client.Client, policy, member string) (bool, error)
+	remove func(ctx context.Context, c *client.Client, policy, member string) error
+}
+
+// policyAttachmentResource attaches a policy to a member. The kinds of attachment share everything
+// except their names and the membership endpoints.
+type policyAttachmentResource struct {
+	client *client.Client
+	policyAttachmentKind
+}
+
+func (r *policyAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+// --- SCHEMA ---
+func (r *policyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.description,
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{
+				Description:   r.policyDescription,
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			r.memberAttribute: schema.StringAttribute{
+				Description:   r.memberDescription,
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *policyAttachmentResource) getNames(ctx context.Context, get func(context.Context, path.Path, interface{}) diag.Diagnostics) (string, string, diag.Diagnostics) {
+	var policy, member types.String
+	diags := get(ctx, path.Root("policy"), &policy)
+	diags.Append(get(ctx, path.Root(r.memberAttribute), &member)...)
+	return policy.ValueString(), member.ValueString(), diags
+}
+
+// --- CREATE ---
+func (r *policyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	policy, member, diags := r.getNames(ctx, req.Plan.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.add(ctx, r.client, policy, member)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Attaching "+r.policyTitle, fmt.Sprintf("Could not attach %s %s to %s %s: %s", r.policyLabel, policy, r.memberLabel, member, err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy"), policy)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.memberAttribute), member)...)
+}
+
+// --- READ ---
+func (r *policyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	policy, member, diags := r.getNames(ctx, req.State.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.exists(ctx, r.client, policy, member)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading "+r.policyTitle+" Attachment", fmt.Sprintf("Could not read attachment of %s %s to %s %s: %s", r.policyLabel, policy, r.memberLabel, member, err.Error()))
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Policy attachment not found, removing from state.", map[string]interface{}{"type": r.typeName, "policy": policy, r.memberAttribute: member})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// --- UPDATE ---
+// Both attributes force replacement, so there is nothing to update.
+func (r *policyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+// --- DELETE ---
+func (r *policyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	policy, member, diags := r.getNames(ctx, req.State.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.remove(ctx, r.client, policy, member)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Detaching "+r.policyTitle, fmt.Sprintf("Could not detach %s %s from %s %s: %s", r.policyLabel, policy, r.memberLabel, member, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *policyAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Attachments are imported with an ID of the form "<policy>,<member>", because both names may contain slashes.
+func (r *policyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <policy>,<%s>, got: %q.", r.memberAttribute, req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.memberAttribute), parts[1])...)
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &qosPolicyResource{}
+	_ resource.ResourceWithConfigure   = &qosPolicyResource{}
+	_ resource.ResourceWithImportState = &qosPolicyResource{}
+)
+
+func NewQosPolicyResource() resource.Resource {
+	return &qosPolicyResource{}
+}
+
+type qosPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type qosPolicyResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	MaxTotalBytesPerSec types.Int64  `tfsdk:"max_total_bytes_per_sec"`
+	MaxTotalOpsPerSec   types.Int64  `tfsdk:"max_total_ops_per_sec"`
+	PolicyType          types.String `tfsdk:"policy_type"`
+}
+
+func (r *qosPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qos_policy"
+}
+
+// --- SCHEMA ---
+func (r *qosPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade Quality of Service policy, which throttles the file systems and buckets it is applied to. " +
+			"Apply it with `qos_policy_name` on `flashblade_file_system`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the QoS policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, the limits of the policy are enforced.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"max_total_bytes_per_sec": schema.Int64Attribute{
+				Description:   "The maximum combined read and write bandwidth of each member, in bytes per second.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"max_total_ops_per_sec": schema.Int64Attribute{
+				Description:   "The maximum combined read, write and metadata operations per second of each member.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API QoS policy to resource model
+func mapQosPolicyToModel(p *fb.QosPolicy, model *qosPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.MaxTotalBytesPerSec = types.Int64PointerValue(p.MaxTotalBytesPerSec)
+	model.MaxTotalOpsPerSec = types.Int64PointerValue(p.MaxTotalOpsPerSec)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+}
+
+// --- CREATE ---
+func (r *qosPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan qosPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.QosPolicy{
+		Enabled:             knownBoolPointer(plan.Enabled),
+		MaxTotalBytesPerSec: knownInt64Pointer(plan.MaxTotalBytesPerSec),
+		MaxTotalOpsPerSec:   knownInt64Pointer(plan.MaxTotalOpsPerSec),
+	}
+	createdPolicy, err := r.client.CreateQosPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating QoS Policy", "Could not create QoS policy: "+err.Error())
+		return
+	}
+
+	mapQosPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *qosPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state qosPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetQosPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading QoS Policy", fmt.Sprintf("Could not read QoS policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "QoS policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapQosPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *qosPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan qosPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToUpdate := fb.QosPolicy{
+		Enabled:             knownBoolPointer(plan.Enabled),
+		MaxTotalBytesPerSec: knownInt64Pointer(plan.MaxTotalBytesPerSec),
+		MaxTotalOpsPerSec:   knownInt64Pointer(plan.MaxTotalOpsPerSec),
+	}
+	updatedPolicy, err := r.client.UpdateQosPolicy(ctx, plan.Name.ValueString(), &policyToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating QoS Policy", fmt.Sprintf("Could not update QoS policy: %s", err.Error()))
+		return
+	}
+
+	mapQosPolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *qosPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state qosPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyName := state.Name.ValueString()
+
+	// The array refuses to delete a policy that is still applied, with an error that doesn't say
+	// where. Check first, so the user knows what to detach.
+	members, err := r.client.ListQosPolicyMembers(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking QoS Policy Members", fmt.Sprintf("Could not list the members of QoS policy %s before deletion: %s", policyName, err.Error()))
+		return
+	}
+	if len(members) > 0 {
+		names := make([]string, 0, len(members))
+		for _, m := range members {
+			if m.Member == nil {
+				continue
+			}
+			name := types.StringPointerValue(m.Member.Name).ValueString()
+			if m.Member.ResourceType != nil {
+				name = fmt.Sprintf("%s (%s)", name, *m.Member.ResourceType)
+			}
+			names = append(names, name)
+		}
+		resp.Diagnostics.AddError("QoS Policy Still In Use",
+			fmt.Sprintf("QoS policy %s cannot be deleted while it is applied to: %s. Remove it from these members first, e.g. by unsetting `qos_policy_name`.", policyName, strings.Join(names, ", ")))
+		return
+	}
+
+	err = r.client.DeleteQosPolicy(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting QoS Policy", fmt.Sprintf("Could not delete QoS policy %s: %s", policyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *qosPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *qosPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
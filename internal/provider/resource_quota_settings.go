@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &quotaSettingsResource{}
+	_ resource.ResourceWithConfigure   = &quotaSettingsResource{}
+	_ resource.ResourceWithImportState = &quotaSettingsResource{}
+)
+
+func NewQuotaSettingsResource() resource.Resource {
+	return &quotaSettingsResource{}
+}
+
+type quotaSettingsResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type quotaSettingsResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Contact                    types.String `tfsdk:"contact"`
+	DirectNotificationsEnabled types.Bool   `tfsdk:"direct_notifications_enabled"`
+}
+
+func (r *quotaSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_settings"
+}
+
+// --- SCHEMA ---
+func (r *quotaSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the array-wide quota notification settings of a Pure Storage FlashBlade. " +
+			"The settings always exist, so creating this resource adopts them and destroying it only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"contact": schema.StringAttribute{
+				Description:   "The contact named in quota notifications sent to users and groups, e.g. an email address of the storage team.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"direct_notifications_enabled": schema.BoolAttribute{
+				Description:   "If true, users and groups are notified directly when they approach or exceed their quota.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Map FB API quota settings to resource model
+func mapQuotaSettingsToModel(s *fb.QuotaSetting, model *quotaSettingsResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Contact = types.StringPointerValue(s.Contact)
+	model.DirectNotificationsEnabled = types.BoolPointerValue(s.DirectNotificationsEnabled)
+}
+
+// update patches the settings with whatever the plan sets and leaves the rest at their current value.
+func (r *quotaSettingsResource) update(ctx context.Context, plan *quotaSettingsResourceModel) error {
+	settingsToUpdate := fb.QuotaSetting{
+		Contact:                    knownStringPointer(plan.Contact),
+		DirectNotificationsEnabled: knownBoolPointer(plan.DirectNotificationsEnabled),
+	}
+	var settings *fb.QuotaSetting
+	var err error
+	if settingsToUpdate.Contact == nil && settingsToUpdate.DirectNotificationsEnabled == nil {
+		settings, err = r.client.GetQuotaSettings(ctx)
+	} else {
+		settings, err = r.client.UpdateQuotaSettings(ctx, &settingsToUpdate)
+	}
+	if err != nil {
+		return err
+	}
+	mapQuotaSettingsToModel(settings, plan)
+	return nil
+}
+
+// --- CREATE ---
+func (r *quotaSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan quotaSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Error Creating Quota Settings", "Could not update quota settings: "+err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *quotaSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state quotaSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetQuotaSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Quota Settings", "Could not read quota settings: "+err.Error())
+		return
+	}
+
+	mapQuotaSettingsToModel(settings, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *quotaSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan quotaSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Error Updating Quota Settings", fmt.Sprintf("Could not update quota settings: %s", err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The settings can't be deleted, so they are left as they are and only dropped from the state.
+func (r *quotaSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Quota settings cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *quotaSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one set of quota settings, so any import ID will do.
+func (r *quotaSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
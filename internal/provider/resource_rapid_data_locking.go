@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &rapidDataLockingResource{}
+	_ resource.ResourceWithConfigure   = &rapidDataLockingResource{}
+	_ resource.ResourceWithImportState = &rapidDataLockingResource{}
+)
+
+func NewRapidDataLockingResource() resource.Resource {
+	return &rapidDataLockingResource{}
+}
+
+type rapidDataLockingResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type rapidDataLockingResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	KmipServer types.String `tfsdk:"kmip_server"`
+}
+
+func (r *rapidDataLockingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rapid_data_locking"
+}
+
+// --- SCHEMA ---
+func (r *rapidDataLockingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages rapid data locking on a Pure Storage FlashBlade, which keeps the key that unlocks the array's data on an external KMIP server. " +
+			"The array always has this configuration, so creating this resource adopts it and destroying it only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A fixed ID, since the configuration has none of its own.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, the array's data can only be unlocked with the key on the KMIP server.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"kmip_server": schema.StringAttribute{
+				Description:   "The name of the `flashblade_kmip` server the key is kept on.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// rapidDataLockingID identifies the configuration in the state, since the API doesn't return an ID.
+const rapidDataLockingID = "rapid-data-locking"
+
+// Map FB API rapid data locking to resource model
+func mapRapidDataLockingToModel(rdl *fb.RapidDataLocking, model *rapidDataLockingResourceModel) {
+	model.ID = types.StringValue(rapidDataLockingID)
+	model.Enabled = types.BoolPointerValue(rdl.Enabled)
+	model.KmipServer = types.StringNull()
+	if rdl.KmipServer != nil {
+		model.KmipServer = types.StringPointerValue(rdl.KmipServer.Name)
+	}
+}
+
+// update patches rapid data locking with whatever the plan sets.
+func (r *rapidDataLockingResource) update(ctx context.Context, plan *rapidDataLockingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	rdlToUpdate := fb.RapidDataLocking{Enabled: knownBoolPointer(plan.Enabled)}
+	if name := knownStringPointer(plan.KmipServer); name != nil {
+		rdlToUpdate.KmipServer = &fb.Reference{Name: name}
+	}
+	if rdlToUpdate.Enabled == nil && rdlToUpdate.KmipServer == nil {
+		current, err := r.client.GetRapidDataLocking(ctx)
+		if err != nil {
+			diags.AddError("Error Reading Rapid Data Locking", "Could not read rapid data locking: "+err.Error())
+			return diags
+		}
+		mapRapidDataLockingToModel(current, plan)
+		return diags
+	}
+
+	updated, err := r.client.UpdateRapidDataLocking(ctx, &rdlToUpdate)
+	if err != nil {
+		diags.AddError("Error Updating Rapid Data Locking", "Could not update rapid data locking: "+err.Error())
+		return diags
+	}
+	mapRapidDataLockingToModel(updated, plan)
+	return diags
+}
+
+// --- CREATE ---
+func (r *rapidDataLockingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rapidDataLockingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *rapidDataLockingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state rapidDataLockingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rdl, err := r.client.GetRapidDataLocking(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Rapid Data Locking", "Could not read rapid data locking: "+err.Error())
+		return
+	}
+
+	mapRapidDataLockingToModel(rdl, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *rapidDataLockingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan rapidDataLockingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+// Rapid data locking is left as it is, since turning it off as a side effect of removing the
+// resource would be surprising. It is only dropped from the state.
+func (r *rapidDataLockingResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Rapid data locking cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *rapidDataLockingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one rapid data locking configuration, so any import ID will do.
+func (r *rapidDataLockingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &smbClientPolicyResource{}
+	_ resource.ResourceWithConfigure   = &smbClientPolicyResource{}
+	_ resource.ResourceWithImportState = &smbClientPolicyResource{}
+)
+
+func NewSmbClientPolicyResource() resource.Resource {
+	return &smbClientPolicyResource{}
+}
+
+type smbClientPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type smbClientPolicyResourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	Name                          types.String `tfsdk:"name"`
+	Enabled                       types.Bool   `tfsdk:"enabled"`
+	AccessBasedEnumerationEnabled types.Bool   `tfsdk:"access_based_enumeration_enabled"`
+	PolicyType                    types.String `tfsdk:"policy_type"`
+	Version                       types.String `tfsdk:"version"`
+}
+
+func (r *smbClientPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_client_policy"
+}
+
+// --- SCHEMA ---
+func (r *smbClientPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade SMB client policy. Rules are managed with `flashblade_smb_client_policy_rule`, " +
+			"and the policy is applied to a file system through the `client_policy_name` attribute of its `smb` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "Whether the policy is enabled.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"access_based_enumeration_enabled": schema.BoolAttribute{
+				Description:   "If true, users only see the files and directories they have access to.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"version":     schema.StringAttribute{Description: "A token that changes whenever the policy or its rules are modified.", Computed: true},
+		},
+	}
+}
+
+// Map FB API SMB client policy to resource model
+func mapSmbClientPolicyToModel(p *fb.SmbClientPolicy, model *smbClientPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.AccessBasedEnumerationEnabled = types.BoolPointerValue(p.AccessBasedEnumerationEnabled)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+	model.Version = types.StringPointerValue(p.Version)
+}
+
+// --- CREATE ---
+func (r *smbClientPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan smbClientPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.SmbClientPolicyPost{
+		Enabled:                       knownBoolPointer(plan.Enabled),
+		AccessBasedEnumerationEnabled: knownBoolPointer(plan.AccessBasedEnumerationEnabled),
+	}
+
+	createdPolicy, err := r.client.CreateSmbClientPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SMB Client Policy", "Could not create SMB client policy: "+err.Error())
+		return
+	}
+
+	mapSmbClientPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *smbClientPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state smbClientPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetSmbClientPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SMB Client Policy", fmt.Sprintf("Could not read SMB client policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "SMB client policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSmbClientPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *smbClientPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state smbClientPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Enabled.Equal(state.Enabled) && plan.AccessBasedEnumerationEnabled.Equal(state.AccessBasedEnumerationEnabled) {
+		tflog.Debug(ctx, "No changes detected for SMB client policy, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	updatedPolicy, err := r.client.UpdateSmbClientPolicy(ctx, plan.Name.ValueString(), &fb.SmbClientPolicy{
+		Enabled:                       knownBoolPointer(plan.Enabled),
+		AccessBasedEnumerationEnabled: knownBoolPointer(plan.AccessBasedEnumerationEnabled),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating SMB Client Policy", fmt.Sprintf("Could not update SMB client policy: %s", err.Error()))
+		return
+	}
+
+	mapSmbClientPolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *smbClientPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state smbClientPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyName := state.Name.ValueString()
+	err := r.client.DeleteSmbClientPolicy(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting SMB Client Policy", fmt.Sprintf("Could not delete SMB client policy %s: %s", policyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *smbClientPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *smbClientPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
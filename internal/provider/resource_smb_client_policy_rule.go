@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &smbClientPolicyRuleResource{}
+	_ resource.ResourceWithConfigure   = &smbClientPolicyRuleResource{}
+	_ resource.ResourceWithImportState = &smbClientPolicyRuleResource{}
+)
+
+func NewSmbClientPolicyRuleResource() resource.Resource {
+	return &smbClientPolicyRuleResource{}
+}
+
+type smbClientPolicyRuleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type smbClientPolicyRuleResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Policy     types.String `tfsdk:"policy"`
+	Name       types.String `tfsdk:"name"`
+	Index      types.Int64  `tfsdk:"index"`
+	Client     types.String `tfsdk:"client"`
+	Encryption types.String `tfsdk:"encryption"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+func (r *smbClientPolicyRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_client_policy_rule"
+}
+
+// --- SCHEMA ---
+func (r *smbClientPolicyRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a rule of a Pure Storage FlashBlade SMB client policy. Rules are evaluated in order of their index.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"policy": schema.StringAttribute{
+				Description:   "The name of the SMB client policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{Description: "The name of the rule, chosen by the array.", Computed: true},
+			"index": schema.Int64Attribute{
+				Description: "The position of the rule in the policy, starting at 1. If omitted, the rule is appended to the end of the policy. " +
+					"The index may be at most one past the last of the other rules, which places the rule last.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"client": schema.StringAttribute{
+				Description:   "The clients the rule applies to, as a hostname, IP address, CIDR block or netgroup. Defaults to `*`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"encryption": schema.StringAttribute{
+				Description:   "Whether matching clients must encrypt SMB traffic. Can be `required`, `disabled` or `optional`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"permission": schema.StringAttribute{
+				Description:   "The access granted to matching clients. Can be `rw` or `ro`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Map FB API SMB client policy rule to resource model
+func mapSmbClientPolicyRuleToModel(rule *fb.SmbClientPolicyRule, model *smbClientPolicyRuleResourceModel) {
+	model.ID = types.StringPointerValue(rule.Id)
+	model.Name = types.StringPointerValue(rule.Name)
+	model.Client = types.StringPointerValue(rule.Client)
+	model.Encryption = types.StringPointerValue(rule.Encryption)
+	model.Permission = types.StringPointerValue(rule.Permission)
+	if rule.Index != nil {
+		model.Index = types.Int64Value(int64(*rule.Index))
+	} else {
+		model.Index = types.Int64Null()
+	}
+	if rule.Policy != nil {
+		model.Policy = types.StringPointerValue(rule.Policy.Name)
+	}
+}
+
+// placeRule looks up where a rule must be placed to end up at index.
+func (r *smbClientPolicyRuleResource) placeRule(ctx context.Context, policy, id string, index int64) (rulePlacement, error) {
+	rules, err := r.client.ListSmbClientPolicyRules(ctx, policy)
+	if err != nil {
+		return rulePlacement{}, err
+	}
+	refs := make([]policyRuleRef, 0, len(rules))
+	for _, rule := range rules {
+		refs = append(refs, policyRuleRef{ID: rule.Id, Name: rule.Name})
+	}
+	return placeRule(refs, id, index)
+}
+
+// --- CREATE ---
+func (r *smbClientPolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan smbClientPolicyRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToCreate := &fb.SmbClientPolicyRulePost{
+		Client:     knownStringPointer(plan.Client),
+		Encryption: knownStringPointer(plan.Encryption),
+		Permission: knownStringPointer(plan.Permission),
+	}
+
+	var before *string
+	if !plan.Index.IsUnknown() && !plan.Index.IsNull() {
+		placement, err := r.placeRule(ctx, plan.Policy.ValueString(), "", plan.Index.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("index"), "Error Creating SMB Client Policy Rule", "Could not determine the position of the rule: "+err.Error())
+			return
+		}
+		before = placement.Before
+	}
+
+	createdRule, err := r.client.CreateSmbClientPolicyRule(ctx, plan.Policy.ValueString(), before, ruleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SMB Client Policy Rule", "Could not create SMB client policy rule: "+err.Error())
+		return
+	}
+
+	mapSmbClientPolicyRuleToModel(createdRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *smbClientPolicyRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state smbClientPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetSmbClientPolicyRuleByID(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SMB Client Policy Rule", fmt.Sprintf("Could not read rule %s of SMB client policy %s: %s", state.ID.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+	if rule == nil {
+		tflog.Warn(ctx, "SMB client policy rule not found, removing from state.", map[string]interface{}{"policy": state.Policy.ValueString(), "id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSmbClientPolicyRuleToModel(rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *smbClientPolicyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state smbClientPolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToUpdate := &fb.SmbClientPolicyRule{
+		Client:     knownStringPointer(plan.Client),
+		Encryption: knownStringPointer(plan.Encryption),
+		Permission: knownStringPointer(plan.Permission),
+	}
+
+	var before *string
+	var lastRule *policyRuleRef
+	if !plan.Index.IsUnknown() && !plan.Index.IsNull() && !plan.Index.Equal(state.Index) {
+		placement, err := r.placeRule(ctx, plan.Policy.ValueString(), state.ID.ValueString(), plan.Index.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("index"), "Error Updating SMB Client Policy Rule", "Could not determine the position of the rule: "+err.Error())
+			return
+		}
+		before = placement.Before
+		if before == nil && placement.Last != nil && placement.Last.ID != nil {
+			before, lastRule = placement.Last.Name, placement.Last
+		}
+	}
+
+	updatedRule, err := r.client.UpdateSmbClientPolicyRule(ctx, state.ID.ValueString(), before, ruleToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating SMB Client Policy Rule", fmt.Sprintf("Could not update SMB client policy rule: %s", err.Error()))
+		return
+	}
+	if lastRule != nil {
+		// The rule now sits in front of the last rule, which is moved in front of it to make the rule last.
+		if _, err := r.client.UpdateSmbClientPolicyRule(ctx, *lastRule.ID, updatedRule.Name, &fb.SmbClientPolicyRule{}); err != nil {
+			resp.Diagnostics.AddError("Error Updating SMB Client Policy Rule", fmt.Sprintf("Could not move the rule to the end of SMB client policy %s: %s", plan.Policy.ValueString(), err.Error()))
+			return
+		}
+		updatedRule, err = r.client.GetSmbClientPolicyRuleByID(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading SMB Client Policy Rule", fmt.Sprintf("Could not read rule %s of SMB client policy %s after moving it: %s", state.ID.ValueString(), plan.Policy.ValueString(), err.Error()))
+			return
+		}
+		if updatedRule == nil {
+			resp.Diagnostics.AddError("Error Reading SMB Client Policy Rule", fmt.Sprintf("Rule %s of SMB client policy %s no longer exists.", state.ID.ValueString(), plan.Policy.ValueString()))
+			return
+		}
+	}
+
+	mapSmbClientPolicyRuleToModel(updatedRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *smbClientPolicyRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state smbClientPolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSmbClientPolicyRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting SMB Client Policy Rule", fmt.Sprintf("Could not delete rule %s of SMB client policy %s: %s", state.Name.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *smbClientPolicyRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Rules are imported by their ID, since rule names follow their position in the policy.
+func (r *smbClientPolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
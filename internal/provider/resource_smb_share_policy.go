@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &smbSharePolicyResource{}
+	_ resource.ResourceWithConfigure   = &smbSharePolicyResource{}
+	_ resource.ResourceWithImportState = &smbSharePolicyResource{}
+)
+
+func NewSmbSharePolicyResource() resource.Resource {
+	return &smbSharePolicyResource{}
+}
+
+type smbSharePolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type smbSharePolicyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	PolicyType types.String `tfsdk:"policy_type"`
+}
+
+func (r *smbSharePolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_share_policy"
+}
+
+// --- SCHEMA ---
+func (r *smbSharePolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade SMB share policy. Rules are managed with `flashblade_smb_share_policy_rule`, " +
+			"and the policy is applied to a file system through the `share_policy_name` attribute of its `smb` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "Whether the policy is enabled.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API SMB share policy to resource model
+func mapSmbSharePolicyToModel(p *fb.SmbSharePolicy, model *smbSharePolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+}
+
+// --- CREATE ---
+func (r *smbSharePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan smbSharePolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.SmbSharePolicyPost{}
+	if !plan.Enabled.IsUnknown() {
+		policyToCreate.Enabled = plan.Enabled.ValueBoolPointer()
+	}
+
+	createdPolicy, err := r.client.CreateSmbSharePolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SMB Share Policy", "Could not create SMB share policy: "+err.Error())
+		return
+	}
+
+	mapSmbSharePolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *smbSharePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state smbSharePolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetSmbSharePolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SMB Share Policy", fmt.Sprintf("Could not read SMB share policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "SMB share policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSmbSharePolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *smbSharePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state smbSharePolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Enabled.Equal(state.Enabled) {
+		tflog.Debug(ctx, "No changes detected for SMB share policy, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	updatedPolicy, err := r.client.UpdateSmbSharePolicy(ctx, plan.Name.ValueString(), &fb.SmbSharePolicy{Enabled: plan.Enabled.ValueBoolPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating SMB Share Policy", fmt.Sprintf("Could not update SMB share policy: %s", err.Error()))
+		return
+	}
+
+	mapSmbSharePolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *smbSharePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state smbSharePolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyName := state.Name.ValueString()
+	err := r.client.DeleteSmbSharePolicy(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting SMB Share Policy", fmt.Sprintf("Could not delete SMB share policy %s: %s", policyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *smbSharePolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *smbSharePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
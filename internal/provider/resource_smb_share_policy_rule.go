@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &smbSharePolicyRuleResource{}
+	_ resource.ResourceWithConfigure   = &smbSharePolicyRuleResource{}
+	_ resource.ResourceWithImportState = &smbSharePolicyRuleResource{}
+)
+
+func NewSmbSharePolicyRuleResource() resource.Resource {
+	return &smbSharePolicyRuleResource{}
+}
+
+type smbSharePolicyRuleResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type smbSharePolicyRuleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Policy      types.String `tfsdk:"policy"`
+	Name        types.String `tfsdk:"name"`
+	Principal   types.String `tfsdk:"principal"`
+	Change      types.String `tfsdk:"change"`
+	Read        types.String `tfsdk:"read"`
+	FullControl types.String `tfsdk:"full_control"`
+}
+
+func (r *smbSharePolicyRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_share_policy_rule"
+}
+
+// --- SCHEMA ---
+func (r *smbSharePolicyRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	permission := func(what string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description:   fmt.Sprintf("Whether the principal is granted (`allow`) or denied (`deny`) %s permission. If unset, the array default applies.", what),
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manages a rule of a Pure Storage FlashBlade SMB share policy, granting share permissions to a user or group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"policy": schema.StringAttribute{
+				Description:   "The name of the SMB share policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{Description: "The name of the rule, chosen by the array.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"principal": schema.StringAttribute{
+				Description: "The user or group the rule applies to, as a name (e.g. `DOMAIN\\group`) or SID. Use `Everyone` for all users.",
+				Required:    true,
+			},
+			"change":       permission("change"),
+			"read":         permission("read"),
+			"full_control": permission("full control"),
+		},
+	}
+}
+
+// Map FB API SMB share policy rule to resource model
+func mapSmbSharePolicyRuleToModel(rule *fb.SmbSharePolicyRuleWithContext, model *smbSharePolicyRuleResourceModel) {
+	model.ID = types.StringPointerValue(rule.Id)
+	model.Name = types.StringPointerValue(rule.Name)
+	model.Principal = types.StringPointerValue(rule.Principal)
+	model.Change = types.StringPointerValue(rule.Change)
+	model.Read = types.StringPointerValue(rule.Read)
+	model.FullControl = types.StringPointerValue(rule.FullControl)
+	if rule.Policy != nil {
+		model.Policy = types.StringPointerValue(rule.Policy.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *smbSharePolicyRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan smbSharePolicyRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToCreate := fb.SmbSharePolicyRulePost{
+		Principal:   plan.Principal.ValueStringPointer(),
+		Change:      knownStringPointer(plan.Change),
+		Read:        knownStringPointer(plan.Read),
+		FullControl: knownStringPointer(plan.FullControl),
+	}
+
+	createdRule, err := r.client.CreateSmbSharePolicyRule(ctx, plan.Policy.ValueString(), &ruleToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SMB Share Policy Rule", "Could not create SMB share policy rule: "+err.Error())
+		return
+	}
+
+	mapSmbSharePolicyRuleToModel(createdRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *smbSharePolicyRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state smbSharePolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetSmbSharePolicyRuleByID(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SMB Share Policy Rule", fmt.Sprintf("Could not read rule %s of SMB share policy %s: %s", state.ID.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+	if rule == nil {
+		tflog.Warn(ctx, "SMB share policy rule not found, removing from state.", map[string]interface{}{"policy": state.Policy.ValueString(), "id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSmbSharePolicyRuleToModel(rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *smbSharePolicyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state smbSharePolicyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleToUpdate := fb.SmbSharePolicyRule{
+		Principal:   plan.Principal.ValueStringPointer(),
+		Change:      knownStringPointer(plan.Change),
+		Read:        knownStringPointer(plan.Read),
+		FullControl: knownStringPointer(plan.FullControl),
+	}
+
+	updatedRule, err := r.client.UpdateSmbSharePolicyRule(ctx, state.ID.ValueString(), &ruleToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating SMB Share Policy Rule", fmt.Sprintf("Could not update SMB share policy rule: %s", err.Error()))
+		return
+	}
+
+	mapSmbSharePolicyRuleToModel(updatedRule, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *smbSharePolicyRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state smbSharePolicyRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSmbSharePolicyRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting SMB Share Policy Rule", fmt.Sprintf("Could not delete rule %s of SMB share policy %s: %s", state.Name.ValueString(), state.Policy.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *smbSharePolicyRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Rules are imported by their ID.
+func (r *smbSharePolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &smtpServerResource{}
+	_ resource.ResourceWithConfigure   = &smtpServerResource{}
+	_ resource.ResourceWithImportState = &smtpServerResource{}
+)
+
+func NewSmtpServerResource() resource.Resource {
+	return &smtpServerResource{}
+}
+
+type smtpServerResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type smtpServerResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	RelayHost      types.String `tfsdk:"relay_host"`
+	SenderDomain   types.String `tfsdk:"sender_domain"`
+	EncryptionMode types.String `tfsdk:"encryption_mode"`
+	TestOnApply    types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *smtpServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smtp_server"
+}
+
+// --- SCHEMA ---
+func (r *smtpServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the SMTP relay a Pure Storage FlashBlade sends alert emails through. " +
+			"The array always has one, so creating this resource adopts it and destroying it only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{Description: "The name of the SMTP server configuration.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"relay_host": schema.StringAttribute{
+				Description:   "The host name or IP address of the relay, optionally followed by `:<port>`. If empty, the array delivers email directly.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"sender_domain": schema.StringAttribute{
+				Description:   "The domain alert emails are sent from.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"encryption_mode": schema.StringAttribute{
+				Description:   "The encryption enforced when sending email, e.g. `starttls`. Set to an empty string to send email without enforcing encryption.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, a test alert is sent to every `flashblade_alert_watcher` after the settings are applied, and the apply fails if any of them can't be delivered. " +
+					"The array has no test for the relay by itself, so this does nothing when there are no alert watchers.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Map FB API SMTP server to resource model
+func mapSmtpServerToModel(s *fb.SmtpServer, model *smtpServerResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Name = types.StringPointerValue(s.Name)
+	model.RelayHost = types.StringPointerValue(s.RelayHost)
+	model.SenderDomain = types.StringPointerValue(s.SenderDomain)
+	model.EncryptionMode = types.StringPointerValue(s.EncryptionMode)
+}
+
+// update patches the SMTP server with whatever the plan sets.
+func (r *smtpServerResource) update(ctx context.Context, plan *smtpServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serverToUpdate := fb.SmtpServer{
+		RelayHost:      knownStringPointer(plan.RelayHost),
+		SenderDomain:   knownStringPointer(plan.SenderDomain),
+		EncryptionMode: knownStringPointer(plan.EncryptionMode),
+	}
+	if serverToUpdate.RelayHost == nil && serverToUpdate.SenderDomain == nil && serverToUpdate.EncryptionMode == nil {
+		current, err := r.client.GetSmtpServer(ctx)
+		if err != nil {
+			diags.AddError("Error Reading SMTP Server", "Could not read the SMTP server: "+err.Error())
+			return diags
+		}
+		mapSmtpServerToModel(current, plan)
+		return diags
+	}
+
+	updated, err := r.client.UpdateSmtpServer(ctx, &serverToUpdate)
+	if err != nil {
+		diags.AddError("Error Updating SMTP Server", "Could not update the SMTP server: "+err.Error())
+		return diags
+	}
+	mapSmtpServerToModel(updated, plan)
+	return diags
+}
+
+// test sends a test alert to all alert watchers if test_on_apply is set.
+func (r *smtpServerResource) test(ctx context.Context, model smtpServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	results, err := r.client.TestAlertWatchers(ctx)
+	if err != nil {
+		diags.AddError("Error Testing SMTP Server", "Could not send test alerts: "+err.Error())
+		return diags
+	}
+	return testResultDiagnostics("SMTP Server Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *smtpServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan smtpServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *smtpServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state smtpServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.GetSmtpServer(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SMTP Server", "Could not read the SMTP server: "+err.Error())
+		return
+	}
+
+	mapSmtpServerToModel(server, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *smtpServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan smtpServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The SMTP server configuration always exists, so it is left as it is and only dropped from the
+// state.
+func (r *smtpServerResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "SMTP server cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *smtpServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one SMTP server configuration, so any import ID will do.
+func (r *smtpServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &snapshotPolicyResource{}
+	_ resource.ResourceWithConfigure   = &snapshotPolicyResource{}
+	_ resource.ResourceWithImportState = &snapshotPolicyResource{}
+)
+
+var snapshotPolicyRuleAttributeTypes = map[string]attr.Type{
+	"every":     types.Int64Type,
+	"at":        types.Int64Type,
+	"keep_for":  types.Int64Type,
+	"time_zone": types.StringType,
+}
+
+func NewSnapshotPolicyResource() resource.Resource {
+	return &snapshotPolicyResource{}
+}
+
+type snapshotPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type snapshotPolicyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	RetentionLock    types.String `tfsdk:"retention_lock"`
+	Rules            types.List   `tfsdk:"rules"`
+	DestroySnapshots types.Bool   `tfsdk:"destroy_snapshots"`
+	PolicyType       types.String `tfsdk:"policy_type"`
+}
+
+type snapshotPolicyRuleModel struct {
+	Every    types.Int64  `tfsdk:"every"`
+	At       types.Int64  `tfsdk:"at"`
+	KeepFor  types.Int64  `tfsdk:"keep_for"`
+	TimeZone types.String `tfsdk:"time_zone"`
+}
+
+func (r *snapshotPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_policy"
+}
+
+// --- SCHEMA ---
+func (r *snapshotPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade snapshot scheduling policy. Attach it to file systems with " +
+			"`flashblade_file_system_policy_attachment` or the `policy_names` attribute of `flashblade_file_system`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "Whether the policy is enabled.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"retention_lock": schema.StringAttribute{
+				Description: "Can be `locked` or `unlocked`. A locked policy cannot be detached from its file systems and its rules cannot be changed. " +
+					"Locking cannot be undone without contacting Pure Technical Services.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The snapshot schedules of the policy.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"every":     schema.Int64Attribute{Description: "How often to take snapshots, in milliseconds.", Required: true},
+						"at":        schema.Int64Attribute{Description: "The time of day to take the snapshot, in milliseconds since midnight. Only valid if `every` is a whole number of days.", Optional: true},
+						"keep_for":  schema.Int64Attribute{Description: "How long to keep the snapshots, in milliseconds.", Required: true},
+						"time_zone": schema.StringAttribute{Description: "The time zone used for `at`. Defaults to the array time zone.", Optional: true},
+					},
+				},
+			},
+			"destroy_snapshots": schema.BoolAttribute{
+				Description: "Must be set to `true` to apply rule changes that would destroy existing snapshots, such as shortening `keep_for`.",
+				Optional:    true,
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API snapshot policy to resource model. Optional rule attributes that were not configured
+// are kept null, so array defaults such as the time zone don't show up as drift.
+func mapSnapshotPolicyToModel(ctx context.Context, p *fb.Policy, model *snapshotPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.RetentionLock = types.StringPointerValue(p.RetentionLock)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+
+	var prior []snapshotPolicyRuleModel
+	if !model.Rules.IsNull() && !model.Rules.IsUnknown() {
+		diags.Append(model.Rules.ElementsAs(ctx, &prior, false)...)
+	}
+
+	ruleType := types.ObjectType{AttrTypes: snapshotPolicyRuleAttributeTypes}
+	if p.Rules == nil || len(*p.Rules) == 0 {
+		model.Rules = types.ListNull(ruleType)
+		return diags
+	}
+	rules := make([]attr.Value, 0, len(*p.Rules))
+	for i, rule := range *p.Rules {
+		at := types.Int64PointerValue(rule.At)
+		timeZone := types.StringPointerValue(rule.TimeZone)
+		if i < len(prior) {
+			if prior[i].At.IsNull() {
+				at = types.Int64Null()
+			}
+			if prior[i].TimeZone.IsNull() {
+				timeZone = types.StringNull()
+			}
+		}
+		rules = append(rules, types.ObjectValueMust(snapshotPolicyRuleAttributeTypes, map[string]attr.Value{
+			"every":     types.Int64PointerValue(rule.Every),
+			"at":        at,
+			"keep_for":  types.Int64PointerValue(rule.KeepFor),
+			"time_zone": timeZone,
+		}))
+	}
+	model.Rules = types.ListValueMust(ruleType, rules)
+	return diags
+}
+
+// snapshotPolicyRulesRequest converts the planned rules into API rules. A null list yields an
+// empty slice, so removing all rules clears them on the array.
+func snapshotPolicyRulesRequest(ctx context.Context, rules types.List) (*[]fb.PolicyRule, diag.Diagnostics) {
+	out := []fb.PolicyRule{}
+	if rules.IsNull() || rules.IsUnknown() {
+		return &out, nil
+	}
+	var planned []snapshotPolicyRuleModel
+	diags := rules.ElementsAs(ctx, &planned, false)
+	for _, rule := range planned {
+		out = append(out, fb.PolicyRule{
+			Every:    rule.Every.ValueInt64Pointer(),
+			At:       rule.At.ValueInt64Pointer(),
+			KeepFor:  rule.KeepFor.ValueInt64Pointer(),
+			TimeZone: rule.TimeZone.ValueStringPointer(),
+		})
+	}
+	return &out, diags
+}
+
+// --- CREATE ---
+func (r *snapshotPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snapshotPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := snapshotPolicyRulesRequest(ctx, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.Policy{
+		Enabled:       knownBoolPointer(plan.Enabled),
+		RetentionLock: knownStringPointer(plan.RetentionLock),
+		Rules:         rules,
+	}
+
+	createdPolicy, err := r.client.CreateSnapshotPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Snapshot Policy", "Could not create snapshot policy: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapSnapshotPolicyToModel(ctx, createdPolicy, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *snapshotPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state snapshotPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetSnapshotPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Snapshot Policy", fmt.Sprintf("Could not read snapshot policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "Snapshot policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSnapshotPolicyToModel(ctx, policy, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *snapshotPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state snapshotPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToUpdate := fb.PolicyPatch{}
+	isPatchNeeded := false
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		isPatchNeeded = true
+		policyToUpdate.Enabled = knownBoolPointer(plan.Enabled)
+	}
+	if !plan.RetentionLock.Equal(state.RetentionLock) {
+		isPatchNeeded = true
+		policyToUpdate.RetentionLock = knownStringPointer(plan.RetentionLock)
+	}
+	if !plan.Rules.Equal(state.Rules) {
+		isPatchNeeded = true
+		rules, diags := snapshotPolicyRulesRequest(ctx, plan.Rules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		policyToUpdate.Rules = rules
+	}
+
+	if !isPatchNeeded {
+		tflog.Debug(ctx, "No changes detected for snapshot policy, skipping API call.")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	updatedPolicy, err := r.client.UpdateSnapshotPolicy(ctx, plan.Name.ValueString(), &policyToUpdate, plan.DestroySnapshots.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Snapshot Policy", fmt.Sprintf("Could not update snapshot policy: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(mapSnapshotPolicyToModel(ctx, updatedPolicy, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *snapshotPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state snapshotPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyName := state.Name.ValueString()
+	err := r.client.DeleteSnapshotPolicy(ctx, policyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Snapshot Policy", fmt.Sprintf("Could not delete snapshot policy %s: %s", policyName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *snapshotPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *snapshotPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
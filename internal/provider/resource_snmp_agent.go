@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &snmpAgentResource{}
+	_ resource.ResourceWithConfigure      = &snmpAgentResource{}
+	_ resource.ResourceWithImportState    = &snmpAgentResource{}
+	_ resource.ResourceWithValidateConfig = &snmpAgentResource{}
+)
+
+func NewSnmpAgentResource() resource.Resource {
+	return &snmpAgentResource{}
+}
+
+type snmpAgentResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type snmpAgentResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	EngineID            types.String `tfsdk:"engine_id"`
+	Version             types.String `tfsdk:"version"`
+	CommunityWO         types.String `tfsdk:"community_wo"`
+	User                types.String `tfsdk:"user"`
+	AuthProtocol        types.String `tfsdk:"auth_protocol"`
+	AuthPassphraseWO    types.String `tfsdk:"auth_passphrase_wo"`
+	PrivacyProtocol     types.String `tfsdk:"privacy_protocol"`
+	PrivacyPassphraseWO types.String `tfsdk:"privacy_passphrase_wo"`
+	SecretsVersion      types.Int64  `tfsdk:"secrets_wo_version"`
+}
+
+func (r *snmpAgentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_agent"
+}
+
+// --- SCHEMA ---
+func (r *snmpAgentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := snmpSecurityAttributes("the agent")
+	attributes["id"] = schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}}
+	attributes["name"] = schema.StringAttribute{Description: "The name of the SNMP agent.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}}
+	attributes["engine_id"] = schema.StringAttribute{Description: "The SNMP engine ID of the agent.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}}
+	attributes["version"] = schema.StringAttribute{
+		Description:   "The SNMP version the agent answers queries with, `v2c` or `v3`.",
+		Optional:      true,
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manages the SNMP agent of a Pure Storage FlashBlade, which answers queries from SNMP managers. " +
+			"The array always has one, so creating this resource adopts it and destroying it only removes it from the state.",
+		Attributes: attributes,
+	}
+}
+
+func (r *snmpAgentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config snmpAgentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateSnmpSecurity(config.Version, config.CommunityWO, config.User, &resp.Diagnostics)
+}
+
+// Map FB API SNMP agent to resource model. The secrets are deliberately left alone, because they
+// are write-only.
+func mapSnmpAgentToModel(a *fb.SnmpAgent, model *snmpAgentResourceModel) {
+	model.ID = types.StringPointerValue(a.Id)
+	model.Name = types.StringPointerValue(a.Name)
+	model.EngineID = types.StringPointerValue(a.EngineId)
+	model.Version = types.StringPointerValue(a.Version)
+	model.User = types.StringNull()
+	model.AuthProtocol = types.StringNull()
+	model.PrivacyProtocol = types.StringNull()
+	if a.V3 != nil {
+		model.User = types.StringPointerValue(a.V3.User)
+		model.AuthProtocol = types.StringPointerValue(a.V3.AuthProtocol)
+		model.PrivacyProtocol = types.StringPointerValue(a.V3.PrivacyProtocol)
+	}
+}
+
+// update patches the SNMP agent with whatever the plan sets. secrets is nil when the write-only
+// credentials don't need to be sent.
+func (r *snmpAgentResource) update(ctx context.Context, plan *snmpAgentResourceModel, secrets *snmpSecrets) diag.Diagnostics {
+	var diags diag.Diagnostics
+	agentToUpdate := fb.SnmpAgent{Version: knownStringPointer(plan.Version)}
+	agentToUpdate.V2c, agentToUpdate.V3 = snmpSecurity(plan.Version, plan.User, plan.AuthProtocol, plan.PrivacyProtocol, secrets)
+	if agentToUpdate.Version == nil {
+		current, err := r.client.GetSnmpAgent(ctx)
+		if err != nil {
+			diags.AddError("Error Reading SNMP Agent", "Could not read the SNMP agent: "+err.Error())
+			return diags
+		}
+		mapSnmpAgentToModel(current, plan)
+		return diags
+	}
+
+	updated, err := r.client.UpdateSnmpAgent(ctx, &agentToUpdate)
+	if err != nil {
+		diags.AddError("Error Updating SNMP Agent", "Could not update the SNMP agent: "+err.Error())
+		return diags
+	}
+	mapSnmpAgentToModel(updated, plan)
+	return diags
+}
+
+// --- CREATE ---
+func (r *snmpAgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snmpAgentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, diags := configuredSnmpSecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan, &secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *snmpAgentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state snmpAgentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agent, err := r.client.GetSnmpAgent(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SNMP Agent", "Could not read the SNMP agent: "+err.Error())
+		return
+	}
+
+	mapSnmpAgentToModel(agent, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Write-only values never show up in a plan diff, so the secrets are only sent again when
+// secrets_wo_version or the SNMP version changes.
+func (r *snmpAgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state snmpAgentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var secrets *snmpSecrets
+	if !plan.SecretsVersion.Equal(state.SecretsVersion) || !plan.Version.Equal(state.Version) {
+		configured, diags := configuredSnmpSecrets(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		secrets = &configured
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan, secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The SNMP agent can't be removed, so it is left as it is and only dropped from the state.
+func (r *snmpAgentResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "SNMP agent cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *snmpAgentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one SNMP agent, so any import ID will do. It keeps its secrets until
+// secrets_wo_version is changed.
+func (r *snmpAgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &snmpManagerResource{}
+	_ resource.ResourceWithConfigure      = &snmpManagerResource{}
+	_ resource.ResourceWithImportState    = &snmpManagerResource{}
+	_ resource.ResourceWithValidateConfig = &snmpManagerResource{}
+)
+
+func NewSnmpManagerResource() resource.Resource {
+	return &snmpManagerResource{}
+}
+
+type snmpManagerResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type snmpManagerResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Host                types.String `tfsdk:"host"`
+	Notification        types.String `tfsdk:"notification"`
+	Version             types.String `tfsdk:"version"`
+	CommunityWO         types.String `tfsdk:"community_wo"`
+	User                types.String `tfsdk:"user"`
+	AuthProtocol        types.String `tfsdk:"auth_protocol"`
+	AuthPassphraseWO    types.String `tfsdk:"auth_passphrase_wo"`
+	PrivacyProtocol     types.String `tfsdk:"privacy_protocol"`
+	PrivacyPassphraseWO types.String `tfsdk:"privacy_passphrase_wo"`
+	SecretsVersion      types.Int64  `tfsdk:"secrets_wo_version"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *snmpManagerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_manager"
+}
+
+// snmpSecurityAttributes are the version and credential attributes shared by SNMP managers and the
+// SNMP agent.
+func snmpSecurityAttributes(what string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"community_wo": schema.StringAttribute{
+			Description: fmt.Sprintf("The community string %s uses with SNMP `v2c`. This value is write-only and is never stored in the state.", what),
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"user": schema.StringAttribute{
+			Description:   fmt.Sprintf("The user name %s uses with SNMP `v3`.", what),
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"auth_protocol": schema.StringAttribute{
+			Description:   "The SNMP `v3` authentication protocol, `MD5` or `SHA`.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"auth_passphrase_wo": schema.StringAttribute{
+			Description: "The SNMP `v3` authentication passphrase. This value is write-only and is never stored in the state.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"privacy_protocol": schema.StringAttribute{
+			Description:   "The SNMP `v3` privacy protocol, `AES` or `DES`.",
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"privacy_passphrase_wo": schema.StringAttribute{
+			Description: "The SNMP `v3` privacy passphrase. This value is write-only and is never stored in the state.",
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+		},
+		"secrets_wo_version": schema.Int64Attribute{
+			Description: "Change this value to send `community_wo`, `auth_passphrase_wo` and `privacy_passphrase_wo` to the array again, e.g. after rotating them.",
+			Optional:    true,
+		},
+	}
+}
+
+// --- SCHEMA ---
+func (r *snmpManagerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := snmpSecurityAttributes("the array")
+	attributes["id"] = schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}}
+	attributes["name"] = schema.StringAttribute{
+		Description:   "The name of the SNMP manager.",
+		Required:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	attributes["host"] = schema.StringAttribute{
+		Description: "The host name or IP address of the SNMP manager, optionally followed by `:<port>`.",
+		Required:    true,
+	}
+	attributes["notification"] = schema.StringAttribute{
+		Description:   "How the array notifies the manager, `trap` or `inform`.",
+		Optional:      true,
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["version"] = schema.StringAttribute{
+		Description: "The SNMP version used to talk to the manager, `v2c` or `v3`.",
+		Required:    true,
+	}
+	attributes["test_on_apply"] = schema.BoolAttribute{
+		Description: "If true, a test notification is sent to the manager after it is created or updated, and the apply fails if it can't be delivered.",
+		Optional:    true,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manages an SNMP manager a Pure Storage FlashBlade sends traps or informs to.",
+		Attributes:  attributes,
+	}
+}
+
+// validateSnmpSecurity checks that the credentials match the SNMP version. Write-only values are
+// only available in the configuration, so this is the one place they can be checked.
+func validateSnmpSecurity(version, community, user types.String, diags *diag.Diagnostics) {
+	if version.IsUnknown() || version.IsNull() {
+		return
+	}
+	switch version.ValueString() {
+	case "v2c":
+		if community.IsNull() {
+			diags.AddAttributeError(path.Root("community_wo"), "Missing SNMP Community", "`community_wo` must be set when `version` is `v2c`.")
+		}
+	case "v3":
+		if user.IsNull() {
+			diags.AddAttributeError(path.Root("user"), "Missing SNMP User", "`user` must be set when `version` is `v3`.")
+		}
+	default:
+		diags.AddAttributeError(path.Root("version"), "Invalid SNMP Version", fmt.Sprintf("`version` must be `v2c` or `v3`, got %q.", version.ValueString()))
+	}
+}
+
+func (r *snmpManagerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config snmpManagerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateSnmpSecurity(config.Version, config.CommunityWO, config.User, &resp.Diagnostics)
+}
+
+// snmpSecrets holds the write-only SNMP credentials, which are only available in the configuration.
+type snmpSecrets struct {
+	Community         types.String
+	AuthPassphrase    types.String
+	PrivacyPassphrase types.String
+}
+
+func configuredSnmpSecrets(ctx context.Context, config tfsdk.Config) (snmpSecrets, diag.Diagnostics) {
+	var secrets snmpSecrets
+	var diags diag.Diagnostics
+	diags.Append(config.GetAttribute(ctx, path.Root("community_wo"), &secrets.Community)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("auth_passphrase_wo"), &secrets.AuthPassphrase)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("privacy_passphrase_wo"), &secrets.PrivacyPassphrase)...)
+	return secrets, diags
+}
+
+// snmpSecurity builds the v2c or v3 settings for the given version. The secrets are left out
+// when secrets is nil, so that an update doesn't have to send them again.
+func snmpSecurity(version, user, authProtocol, privacyProtocol types.String, secrets *snmpSecrets) (*fb.SnmpV2c, *fb.SnmpV3) {
+	switch version.ValueString() {
+	case "v2c":
+		if secrets == nil {
+			return nil, nil
+		}
+		return &fb.SnmpV2c{Community: knownStringPointer(secrets.Community)}, nil
+	case "v3":
+		v3 := &fb.SnmpV3{
+			User:            knownStringPointer(user),
+			AuthProtocol:    knownStringPointer(authProtocol),
+			PrivacyProtocol: knownStringPointer(privacyProtocol),
+		}
+		if secrets != nil {
+			v3.AuthPassphrase = knownStringPointer(secrets.AuthPassphrase)
+			v3.PrivacyPassphrase = knownStringPointer(secrets.PrivacyPassphrase)
+		}
+		return nil, v3
+	}
+	return nil, nil
+}
+
+// Map FB API SNMP manager to resource model. The secrets are deliberately left alone, because they
+// are write-only.
+func mapSnmpManagerToModel(m *fb.SnmpManager, model *snmpManagerResourceModel) {
+	model.ID = types.StringPointerValue(m.Id)
+	model.Name = types.StringPointerValue(m.Name)
+	model.Host = types.StringPointerValue(m.Host)
+	model.Notification = types.StringPointerValue(m.Notification)
+	model.Version = types.StringPointerValue(m.Version)
+	model.User = types.StringNull()
+	model.AuthProtocol = types.StringNull()
+	model.PrivacyProtocol = types.StringNull()
+	if m.V3 != nil {
+		model.User = types.StringPointerValue(m.V3.User)
+		model.AuthProtocol = types.StringPointerValue(m.V3.AuthProtocol)
+		model.PrivacyProtocol = types.StringPointerValue(m.V3.PrivacyProtocol)
+	}
+}
+
+// test sends a test notification to the manager if test_on_apply is set.
+func (r *snmpManagerResource) test(ctx context.Context, model snmpManagerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestSnmpManager(ctx, name)
+	if err != nil {
+		diags.AddError("Error Testing SNMP Manager", fmt.Sprintf("Could not test SNMP manager %s: %s", name, err.Error()))
+		return diags
+	}
+	return testResultDiagnostics("SNMP Manager Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *snmpManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snmpManagerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, diags := configuredSnmpSecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managerToCreate := fb.SnmpManagerPost{
+		Host:         plan.Host.ValueStringPointer(),
+		Notification: knownStringPointer(plan.Notification),
+		Version:      plan.Version.ValueStringPointer(),
+	}
+	v2c, v3 := snmpSecurity(plan.Version, plan.User, plan.AuthProtocol, plan.PrivacyProtocol, &secrets)
+	managerToCreate.V2c = v2c
+	if v3 != nil {
+		managerToCreate.V3 = &fb.SnmpV3Post{
+			User:              v3.User,
+			AuthProtocol:      v3.AuthProtocol,
+			AuthPassphrase:    v3.AuthPassphrase,
+			PrivacyProtocol:   v3.PrivacyProtocol,
+			PrivacyPassphrase: v3.PrivacyPassphrase,
+		}
+	}
+	createdManager, err := r.client.CreateSnmpManager(ctx, plan.Name.ValueString(), &managerToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SNMP Manager", "Could not create SNMP manager: "+err.Error())
+		return
+	}
+
+	mapSnmpManagerToModel(createdManager, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *snmpManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state snmpManagerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manager, err := r.client.GetSnmpManagerByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SNMP Manager", fmt.Sprintf("Could not read SNMP manager %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if manager == nil {
+		tflog.Warn(ctx, "SNMP manager not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSnmpManagerToModel(manager, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// Write-only values never show up in a plan diff, so the secrets are only sent again when
+// secrets_wo_version or the SNMP version changes.
+func (r *snmpManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state snmpManagerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var secrets *snmpSecrets
+	if !plan.SecretsVersion.Equal(state.SecretsVersion) || !plan.Version.Equal(state.Version) {
+		configured, diags := configuredSnmpSecrets(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		secrets = &configured
+	}
+
+	managerToUpdate := fb.SnmpManager{
+		Host:         plan.Host.ValueStringPointer(),
+		Notification: knownStringPointer(plan.Notification),
+		Version:      plan.Version.ValueStringPointer(),
+	}
+	managerToUpdate.V2c, managerToUpdate.V3 = snmpSecurity(plan.Version, plan.User, plan.AuthProtocol, plan.PrivacyProtocol, secrets)
+	updatedManager, err := r.client.UpdateSnmpManager(ctx, plan.Name.ValueString(), &managerToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating SNMP Manager", fmt.Sprintf("Could not update SNMP manager: %s", err.Error()))
+		return
+	}
+
+	mapSnmpManagerToModel(updatedManager, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *snmpManagerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state snmpManagerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSnmpManager(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting SNMP Manager", fmt.Sprintf("Could not delete SNMP manager %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *snmpManagerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Imported managers keep their secrets until secrets_wo_version is changed.
+func (r *snmpManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &ssoOidcIdpResource{}
+	_ resource.ResourceWithConfigure   = &ssoOidcIdpResource{}
+	_ resource.ResourceWithImportState = &ssoOidcIdpResource{}
+)
+
+func NewSsoOidcIdpResource() resource.Resource {
+	return &ssoOidcIdpResource{}
+}
+
+type ssoOidcIdpResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type ssoOidcIdpResourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	Name                          types.String `tfsdk:"name"`
+	Enabled                       types.Bool   `tfsdk:"enabled"`
+	Services                      types.Set    `tfsdk:"services"`
+	ProviderURL                   types.String `tfsdk:"provider_url"`
+	ProviderURLCaCertificate      types.String `tfsdk:"provider_url_ca_certificate"`
+	ProviderURLCaCertificateGroup types.String `tfsdk:"provider_url_ca_certificate_group"`
+}
+
+func (r *ssoOidcIdpResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_oidc_idp"
+}
+
+// --- SCHEMA ---
+func (r *ssoOidcIdpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an OpenID Connect identity provider a Pure Storage FlashBlade federates logins to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the identity provider configuration.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, logins are federated to the identity provider.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"services": schema.SetAttribute{
+				Description:   "The services logins are federated for, e.g. `object`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"provider_url": schema.StringAttribute{
+				Description: "The issuer URL of the identity provider, under which its discovery document is published.",
+				Required:    true,
+			},
+			"provider_url_ca_certificate": schema.StringAttribute{
+				Description:   "The name of the CA certificate used to verify `provider_url`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"provider_url_ca_certificate_group": schema.StringAttribute{
+				Description:   "The name of the certificate group used to verify `provider_url`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Map FB API OIDC identity provider to resource model
+func mapSsoOidcIdpToModel(s *fb.OidcSso, model *ssoOidcIdpResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Name = types.StringPointerValue(s.Name)
+	model.Enabled = types.BoolPointerValue(s.Enabled)
+	model.Services = stringSetValue(s.Services)
+
+	idp := s.Idp
+	if idp == nil {
+		idp = &fb.OidcSsoPostIdp{}
+	}
+	model.ProviderURL = types.StringPointerValue(idp.ProviderUrl)
+	model.ProviderURLCaCertificate = types.StringNull()
+	if idp.ProviderUrlCaCertificate != nil {
+		model.ProviderURLCaCertificate = types.StringPointerValue(idp.ProviderUrlCaCertificate.Name)
+	}
+	model.ProviderURLCaCertificateGroup = types.StringNull()
+	if idp.ProviderUrlCaCertificateGroup != nil {
+		model.ProviderURLCaCertificateGroup = types.StringPointerValue(idp.ProviderUrlCaCertificateGroup.Name)
+	}
+}
+
+func ssoOidcIdpFromPlan(ctx context.Context, plan ssoOidcIdpResourceModel) (fb.OidcSsoPatch, diag.Diagnostics) {
+	services, diags := stringsFromSet(ctx, plan.Services)
+	idp := &fb.OidcSsoPostIdp{ProviderUrl: knownStringPointer(plan.ProviderURL)}
+	if name := knownStringPointer(plan.ProviderURLCaCertificate); name != nil {
+		idp.ProviderUrlCaCertificate = &fb.Reference{Name: name}
+	}
+	if name := knownStringPointer(plan.ProviderURLCaCertificateGroup); name != nil {
+		idp.ProviderUrlCaCertificateGroup = &fb.Reference{Name: name}
+	}
+	return fb.OidcSsoPatch{
+		Enabled:  knownBoolPointer(plan.Enabled),
+		Services: services,
+		Idp:      idp,
+	}, diags
+}
+
+// --- CREATE ---
+func (r *ssoOidcIdpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ssoOidcIdpResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idp, diags := ssoOidcIdpFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idpToCreate := fb.OidcSsoPost{
+		Enabled:  idp.Enabled,
+		Services: idp.Services,
+		Idp:      idp.Idp,
+	}
+	createdIdp, err := r.client.CreateSsoOidcIdp(ctx, plan.Name.ValueString(), &idpToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating OIDC Identity Provider", "Could not create OIDC identity provider: "+err.Error())
+		return
+	}
+
+	mapSsoOidcIdpToModel(createdIdp, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *ssoOidcIdpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ssoOidcIdpResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idp, err := r.client.GetSsoOidcIdpByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading OIDC Identity Provider", fmt.Sprintf("Could not read OIDC identity provider %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if idp == nil {
+		tflog.Warn(ctx, "OIDC identity provider not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSsoOidcIdpToModel(idp, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *ssoOidcIdpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ssoOidcIdpResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idpToUpdate, diags := ssoOidcIdpFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatedIdp, err := r.client.UpdateSsoOidcIdp(ctx, plan.Name.ValueString(), &idpToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating OIDC Identity Provider", fmt.Sprintf("Could not update OIDC identity provider: %s", err.Error()))
+		return
+	}
+
+	mapSsoOidcIdpToModel(updatedIdp, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *ssoOidcIdpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ssoOidcIdpResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSsoOidcIdp(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting OIDC Identity Provider", fmt.Sprintf("Could not delete OIDC identity provider %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *ssoOidcIdpResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *ssoOidcIdpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
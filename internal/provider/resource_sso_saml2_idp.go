@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &ssoSaml2IdpResource{}
+	_ resource.ResourceWithConfigure   = &ssoSaml2IdpResource{}
+	_ resource.ResourceWithImportState = &ssoSaml2IdpResource{}
+)
+
+func NewSsoSaml2IdpResource() resource.Resource {
+	return &ssoSaml2IdpResource{}
+}
+
+type ssoSaml2IdpResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type ssoSaml2IdpResourceModel struct {
+	ID                               types.String `tfsdk:"id"`
+	Name                             types.String `tfsdk:"name"`
+	ArrayURL                         types.String `tfsdk:"array_url"`
+	Binding                          types.String `tfsdk:"binding"`
+	Enabled                          types.Bool   `tfsdk:"enabled"`
+	Services                         types.Set    `tfsdk:"services"`
+	IdpEntityID                      types.String `tfsdk:"idp_entity_id"`
+	IdpURL                           types.String `tfsdk:"idp_url"`
+	IdpMetadataURL                   types.String `tfsdk:"idp_metadata_url"`
+	IdpMetadataURLCaCertificate      types.String `tfsdk:"idp_metadata_url_ca_certificate"`
+	IdpMetadataURLCaCertificateGroup types.String `tfsdk:"idp_metadata_url_ca_certificate_group"`
+	IdpVerificationCertificate       types.String `tfsdk:"idp_verification_certificate"`
+	SignRequestEnabled               types.Bool   `tfsdk:"sign_request_enabled"`
+	EncryptAssertionEnabled          types.Bool   `tfsdk:"encrypt_assertion_enabled"`
+	SpSigningCredential              types.String `tfsdk:"sp_signing_credential"`
+	SpDecryptionCredential           types.String `tfsdk:"sp_decryption_credential"`
+	SpEntityID                       types.String `tfsdk:"sp_entity_id"`
+	SpMetadataURL                    types.String `tfsdk:"sp_metadata_url"`
+	SpAssertionConsumerURL           types.String `tfsdk:"sp_assertion_consumer_url"`
+	TestOnApply                      types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *ssoSaml2IdpResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_saml2_idp"
+}
+
+// --- SCHEMA ---
+func (r *ssoSaml2IdpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description:   description,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	optionalBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Description:   description,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		}
+	}
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Description: description, Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a SAML2 identity provider a Pure Storage FlashBlade federates logins to, e.g. for the GUI. " +
+			"Register the array with the identity provider using `sp_entity_id` and `sp_assertion_consumer_url`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the identity provider configuration.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"array_url": schema.StringAttribute{
+				Description: "The URL users reach the array at, e.g. `https://flashblade.example.com`.",
+				Required:    true,
+			},
+			"binding": optionalString("The SAML binding used to send authentication requests to the identity provider."),
+			"enabled": optionalBool("If true, logins are federated to the identity provider."),
+			"services": schema.SetAttribute{
+				Description:   "The services logins are federated for, e.g. `management`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"idp_entity_id":                         optionalString("The entity ID of the identity provider."),
+			"idp_url":                               optionalString("The URL users are redirected to for authentication."),
+			"idp_metadata_url":                      optionalString("The URL of the identity provider's metadata."),
+			"idp_metadata_url_ca_certificate":       optionalString("The name of the CA certificate used to verify `idp_metadata_url`."),
+			"idp_metadata_url_ca_certificate_group": optionalString("The name of the certificate group used to verify `idp_metadata_url`."),
+			"idp_verification_certificate":          optionalString("The name of the certificate used to verify the identity provider's responses."),
+			"sign_request_enabled":                  optionalBool("If true, authentication requests are signed with `sp_signing_credential`."),
+			"encrypt_assertion_enabled":             optionalBool("If true, the identity provider encrypts its assertions for `sp_decryption_credential`."),
+			"sp_signing_credential":                 optionalString("The name of the `flashblade_certificate` the array signs its requests with."),
+			"sp_decryption_credential":              optionalString("The name of the `flashblade_certificate` the array decrypts assertions with."),
+			"sp_entity_id":                          computedString("The entity ID of the array as a service provider."),
+			"sp_metadata_url":                       computedString("The URL of the array's service provider metadata."),
+			"sp_assertion_consumer_url":             computedString("The URL the identity provider sends its assertions to."),
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, the identity provider is tested after it is created, and changes are tested before they are applied. A failed check fails the apply.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// referenceWritableName returns the name of a reference, or null if there is none.
+func referenceWritableName(ref *fb.ReferenceWritable) types.String {
+	if ref == nil {
+		return types.StringNull()
+	}
+	return types.StringPointerValue(ref.Name)
+}
+
+// knownReferenceWritable refers to an object by name if the name is known.
+func knownReferenceWritable(v types.String) *fb.ReferenceWritable {
+	name := knownStringPointer(v)
+	if name == nil {
+		return nil
+	}
+	return &fb.ReferenceWritable{Name: name}
+}
+
+// Map FB API SAML2 identity provider to resource model
+func mapSsoSaml2IdpToModel(s *fb.Saml2Sso, model *ssoSaml2IdpResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Name = types.StringPointerValue(s.Name)
+	model.ArrayURL = types.StringPointerValue(s.ArrayUrl)
+	model.Binding = types.StringPointerValue(s.Binding)
+	model.Enabled = types.BoolPointerValue(s.Enabled)
+	model.Services = stringSetValue(s.Services)
+
+	idp := s.Idp
+	if idp == nil {
+		idp = &fb.Saml2SsoIdp{}
+	}
+	model.IdpEntityID = types.StringPointerValue(idp.EntityId)
+	model.IdpURL = types.StringPointerValue(idp.Url)
+	model.IdpMetadataURL = types.StringPointerValue(idp.MetadataUrl)
+	model.IdpMetadataURLCaCertificate = referenceWritableName(idp.MetadataUrlCaCertificate)
+	model.IdpMetadataURLCaCertificateGroup = referenceWritableName(idp.MetadataUrlCaCertificateGroup)
+	model.IdpVerificationCertificate = referenceWritableName(idp.VerificationCertificate)
+	model.SignRequestEnabled = types.BoolPointerValue(idp.SignRequestEnabled)
+	model.EncryptAssertionEnabled = types.BoolPointerValue(idp.EncryptAssertionEnabled)
+
+	sp := s.Sp
+	if sp == nil {
+		sp = &fb.Saml2SsoSp{}
+	}
+	model.SpSigningCredential = referenceWritableName(sp.SigningCredential)
+	model.SpDecryptionCredential = referenceWritableName(sp.DecryptionCredential)
+	model.SpEntityID = types.StringPointerValue(sp.EntityId)
+	model.SpMetadataURL = types.StringPointerValue(sp.MetadataUrl)
+	model.SpAssertionConsumerURL = types.StringPointerValue(sp.AssertionConsumerUrl)
+}
+
+func ssoSaml2IdpFromPlan(ctx context.Context, plan ssoSaml2IdpResourceModel) (fb.Saml2Sso, diag.Diagnostics) {
+	services, diags := stringsFromSet(ctx, plan.Services)
+	return fb.Saml2Sso{
+		ArrayUrl: knownStringPointer(plan.ArrayURL),
+		Binding:  knownStringPointer(plan.Binding),
+		Enabled:  knownBoolPointer(plan.Enabled),
+		Services: services,
+		Idp: &fb.Saml2SsoIdp{
+			EntityId:                      knownStringPointer(plan.IdpEntityID),
+			Url:                           knownStringPointer(plan.IdpURL),
+			MetadataUrl:                   knownStringPointer(plan.IdpMetadataURL),
+			MetadataUrlCaCertificate:      knownReferenceWritable(plan.IdpMetadataURLCaCertificate),
+			MetadataUrlCaCertificateGroup: knownReferenceWritable(plan.IdpMetadataURLCaCertificateGroup),
+			VerificationCertificate:       knownReferenceWritable(plan.IdpVerificationCertificate),
+			SignRequestEnabled:            knownBoolPointer(plan.SignRequestEnabled),
+			EncryptAssertionEnabled:       knownBoolPointer(plan.EncryptAssertionEnabled),
+		},
+		Sp: &fb.Saml2SsoSp{
+			SigningCredential:    knownReferenceWritable(plan.SpSigningCredential),
+			DecryptionCredential: knownReferenceWritable(plan.SpDecryptionCredential),
+		},
+	}, diags
+}
+
+// test checks the identity provider if test_on_apply is set.
+func (r *ssoSaml2IdpResource) test(ctx context.Context, model ssoSaml2IdpResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestSsoSaml2Idp(ctx, name)
+	if err != nil {
+		diags.AddError("Error Testing SAML2 Identity Provider", fmt.Sprintf("Could not test SAML2 identity provider %s: %s", name, err.Error()))
+		return diags
+	}
+	return testResultDiagnostics("SAML2 Identity Provider Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *ssoSaml2IdpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ssoSaml2IdpResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idp, diags := ssoSaml2IdpFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idpToCreate := fb.Saml2SsoPost{
+		ArrayUrl: idp.ArrayUrl,
+		Binding:  idp.Binding,
+		Enabled:  idp.Enabled,
+		Services: idp.Services,
+		Idp:      idp.Idp,
+		Sp:       idp.Sp,
+	}
+	createdIdp, err := r.client.CreateSsoSaml2Idp(ctx, plan.Name.ValueString(), &idpToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating SAML2 Identity Provider", "Could not create SAML2 identity provider: "+err.Error())
+		return
+	}
+
+	mapSsoSaml2IdpToModel(createdIdp, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *ssoSaml2IdpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ssoSaml2IdpResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idp, err := r.client.GetSsoSaml2IdpByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SAML2 Identity Provider", fmt.Sprintf("Could not read SAML2 identity provider %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if idp == nil {
+		tflog.Warn(ctx, "SAML2 identity provider not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSsoSaml2IdpToModel(idp, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+// With test_on_apply, the changes are tested before they are applied, so a broken configuration
+// doesn't lock users out of the GUI.
+func (r *ssoSaml2IdpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ssoSaml2IdpResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idpToUpdate, diags := ssoSaml2IdpFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	if plan.TestOnApply.ValueBool() {
+		results, err := r.client.TestSsoSaml2IdpChanges(ctx, name, &idpToUpdate)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Testing SAML2 Identity Provider", fmt.Sprintf("Could not test the changes to SAML2 identity provider %s: %s", name, err.Error()))
+			return
+		}
+		resp.Diagnostics.Append(testResultDiagnostics("SAML2 Identity Provider Test Failed", results)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updatedIdp, err := r.client.UpdateSsoSaml2Idp(ctx, name, &idpToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating SAML2 Identity Provider", fmt.Sprintf("Could not update SAML2 identity provider: %s", err.Error()))
+		return
+	}
+
+	mapSsoSaml2IdpToModel(updatedIdp, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *ssoSaml2IdpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ssoSaml2IdpResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSsoSaml2Idp(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting SAML2 Identity Provider", fmt.Sprintf("Could not delete SAML2 identity provider %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *ssoSaml2IdpResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *ssoSaml2IdpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &subnetResource{}
+	_ resource.ResourceWithConfigure   = &subnetResource{}
+	_ resource.ResourceWithImportState = &subnetResource{}
+)
+
+func NewSubnetResource() resource.Resource {
+	return &subnetResource{}
+}
+
+type subnetResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type subnetResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Prefix               types.String `tfsdk:"prefix"`
+	Gateway              types.String `tfsdk:"gateway"`
+	Mtu                  types.Int64  `tfsdk:"mtu"`
+	Vlan                 types.Int64  `tfsdk:"vlan"`
+	LinkAggregationGroup types.String `tfsdk:"link_aggregation_group"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Services             types.Set    `tfsdk:"services"`
+	Interfaces           types.Set    `tfsdk:"interfaces"`
+}
+
+func (r *subnetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet"
+}
+
+// --- SCHEMA ---
+func (r *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade subnet, which network interfaces are created in.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the subnet.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "The IPv4 or IPv6 address range of the subnet in CIDR notation, e.g. `10.21.200.0/24`.",
+				Required:    true,
+			},
+			"gateway": schema.StringAttribute{
+				Description:   "The IP address of the gateway of the subnet.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"mtu": schema.Int64Attribute{
+				Description:   "The maximum transmission unit of the subnet, between 1280 and 9216.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"vlan": schema.Int64Attribute{
+				Description:   "The VLAN ID of the subnet, or 0 for untagged traffic.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"link_aggregation_group": schema.StringAttribute{
+				Description:   "The name of the link aggregation group the subnet is configured on, e.g. the `name` of a `flashblade_link_aggregation_group`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled":    schema.BoolAttribute{Description: "Whether the subnet is enabled.", Computed: true},
+			"services":   schema.SetAttribute{Description: "The services provided by the interfaces in the subnet.", ElementType: types.StringType, Computed: true},
+			"interfaces": schema.SetAttribute{Description: "The names of the network interfaces in the subnet.", ElementType: types.StringType, Computed: true},
+		},
+	}
+}
+
+// Map FB API subnet to resource model
+func mapSubnetToModel(s *fb.Subnet, model *subnetResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Name = types.StringPointerValue(s.Name)
+	model.Prefix = types.StringPointerValue(s.Prefix)
+	model.Gateway = types.StringPointerValue(s.Gateway)
+	model.Mtu = int32PointerValue(s.Mtu)
+	model.Vlan = int32PointerValue(s.Vlan)
+	model.Enabled = types.BoolPointerValue(s.Enabled)
+	model.Services = stringSetValue(s.Services)
+	model.LinkAggregationGroup = types.StringNull()
+	if s.LinkAggregationGroup != nil {
+		model.LinkAggregationGroup = types.StringPointerValue(s.LinkAggregationGroup.Name)
+	}
+
+	var interfaces []string
+	if s.Interfaces != nil {
+		for _, i := range *s.Interfaces {
+			if i.Name != nil {
+				interfaces = append(interfaces, *i.Name)
+			}
+		}
+	}
+	model.Interfaces = stringSetValue(&interfaces)
+}
+
+func subnetFromPlan(plan subnetResourceModel) fb.Subnet {
+	subnet := fb.Subnet{
+		Prefix:  plan.Prefix.ValueStringPointer(),
+		Gateway: knownStringPointer(plan.Gateway),
+		Mtu:     knownInt32Pointer(plan.Mtu),
+		Vlan:    knownInt32Pointer(plan.Vlan),
+	}
+	if lag := knownStringPointer(plan.LinkAggregationGroup); lag != nil {
+		subnet.LinkAggregationGroup = &fb.Reference{Name: lag}
+	}
+	return subnet
+}
+
+// --- CREATE ---
+func (r *subnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subnetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnetToCreate := subnetFromPlan(plan)
+	createdSubnet, err := r.client.CreateSubnet(ctx, plan.Name.ValueString(), &subnetToCreate)
+	if err != nil {
+		detail := "Could not create subnet: " + err.Error()
+		if !plan.LinkAggregationGroup.IsNull() {
+			detail += fmt.Sprintf("\n\nCheck that link aggregation group %s exists. If it is managed by Terraform, reference its `name` attribute so it is created first.", plan.LinkAggregationGroup.ValueString())
+		}
+		resp.Diagnostics.AddError("Error Creating Subnet", detail)
+		return
+	}
+
+	mapSubnetToModel(createdSubnet, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *subnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, err := r.client.GetSubnetByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Subnet", fmt.Sprintf("Could not read subnet %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if subnet == nil {
+		tflog.Warn(ctx, "Subnet not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSubnetToModel(subnet, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *subnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnetToUpdate := subnetFromPlan(plan)
+	updatedSubnet, err := r.client.UpdateSubnet(ctx, plan.Name.ValueString(), &subnetToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Subnet", fmt.Sprintf("Could not update subnet: %s", err.Error()))
+		return
+	}
+
+	mapSubnetToModel(updatedSubnet, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnetName := state.Name.ValueString()
+
+	// The array refuses to delete a subnet that still has interfaces. Check first, so the error
+	// names them.
+	subnet, err := r.client.GetSubnetByName(ctx, subnetName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Subnet Interfaces", fmt.Sprintf("Could not read subnet %s before deletion: %s", subnetName, err.Error()))
+		return
+	}
+	if subnet == nil {
+		return
+	}
+	if subnet.Interfaces != nil && len(*subnet.Interfaces) > 0 {
+		names := make([]string, 0, len(*subnet.Interfaces))
+		for _, i := range *subnet.Interfaces {
+			names = append(names, types.StringPointerValue(i.Name).ValueString())
+		}
+		resp.Diagnostics.AddError("Subnet Still In Use",
+			fmt.Sprintf("Subnet %s cannot be deleted while it has network interfaces: %s. Delete these interfaces first, e.g. by destroying their `flashblade_network_interface` resources.", subnetName, strings.Join(names, ", ")))
+		return
+	}
+
+	err = r.client.DeleteSubnet(ctx, subnetName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Subnet", fmt.Sprintf("Could not delete subnet %s: %s", subnetName, err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *subnetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *subnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
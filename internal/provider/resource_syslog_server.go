@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &syslogServerResource{}
+	_ resource.ResourceWithConfigure   = &syslogServerResource{}
+	_ resource.ResourceWithImportState = &syslogServerResource{}
+)
+
+func NewSyslogServerResource() resource.Resource {
+	return &syslogServerResource{}
+}
+
+type syslogServerResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type syslogServerResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URI         types.String `tfsdk:"uri"`
+	Services    types.Set    `tfsdk:"services"`
+	TestOnApply types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *syslogServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_syslog_server"
+}
+
+// --- SCHEMA ---
+func (r *syslogServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a remote syslog server a Pure Storage FlashBlade forwards its logs to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the syslog server.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"uri": schema.StringAttribute{
+				Description: "The URI of the syslog server, in the form `<protocol>://<host>:<port>`, where the protocol is `tcp` or `udp`.",
+				Required:    true,
+			},
+			"services": schema.SetAttribute{
+				Description:   "The services whose logs are forwarded, e.g. `data-audit` or `management`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, a test message is sent to the server after it is created or updated, and the apply fails if it can't be delivered.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Map FB API syslog server to resource model
+func mapSyslogServerToModel(s *fb.SyslogServer, model *syslogServerResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Name = types.StringPointerValue(s.Name)
+	model.URI = types.StringPointerValue(s.Uri)
+	model.Services = stringSetValue(s.Services)
+}
+
+// test sends a test message to the server if test_on_apply is set. The array tests all syslog
+// servers at once, so only the results for this one are looked at.
+func (r *syslogServerResource) test(ctx context.Context, model syslogServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	name := model.Name.ValueString()
+	results, err := r.client.TestSyslogServers(ctx)
+	if err != nil {
+		diags.AddError("Error Testing Syslog Server", fmt.Sprintf("Could not test syslog server %s: %s", name, err.Error()))
+		return diags
+	}
+	var own []fb.TestResult
+	for _, result := range results {
+		if result.Resource != nil && result.Resource.Name != nil && *result.Resource.Name == name {
+			own = append(own, result)
+		}
+	}
+	if len(own) == 0 {
+		diags.AddError("Syslog Server Test Failed", fmt.Sprintf("The array returned no test result for syslog server %s.", name))
+		return diags
+	}
+	return testResultDiagnostics("Syslog Server Test Failed", own)
+}
+
+// --- CREATE ---
+func (r *syslogServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan syslogServerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, diags := stringsFromSet(ctx, plan.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverToCreate := fb.SyslogServerPost{
+		Uri:      plan.URI.ValueStringPointer(),
+		Services: services,
+	}
+	createdServer, err := r.client.CreateSyslogServer(ctx, plan.Name.ValueString(), &serverToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Syslog Server", "Could not create syslog server: "+err.Error())
+		return
+	}
+
+	mapSyslogServerToModel(createdServer, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *syslogServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state syslogServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.GetSyslogServerByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Syslog Server", fmt.Sprintf("Could not read syslog server %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if server == nil {
+		tflog.Warn(ctx, "Syslog server not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapSyslogServerToModel(server, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *syslogServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan syslogServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, diags := stringsFromSet(ctx, plan.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverToUpdate := fb.SyslogServerPatch{
+		Uri:      plan.URI.ValueStringPointer(),
+		Services: services,
+	}
+	updatedServer, err := r.client.UpdateSyslogServer(ctx, plan.Name.ValueString(), &serverToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Syslog Server", fmt.Sprintf("Could not update syslog server: %s", err.Error()))
+		return
+	}
+
+	mapSyslogServerToModel(updatedServer, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *syslogServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state syslogServerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSyslogServer(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Syslog Server", fmt.Sprintf("Could not delete syslog server %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *syslogServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *syslogServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &syslogSettingsResource{}
+	_ resource.ResourceWithConfigure   = &syslogSettingsResource{}
+	_ resource.ResourceWithImportState = &syslogSettingsResource{}
+)
+
+func NewSyslogSettingsResource() resource.Resource {
+	return &syslogSettingsResource{}
+}
+
+type syslogSettingsResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type syslogSettingsResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	CaCertificate      types.String `tfsdk:"ca_certificate"`
+	CaCertificateGroup types.String `tfsdk:"ca_certificate_group"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (r *syslogSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_syslog_settings"
+}
+
+// --- SCHEMA ---
+func (r *syslogSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings a Pure Storage FlashBlade applies to all of its syslog servers, such as the certificates used to verify servers reached over TLS. " +
+			"The array always has these settings, so creating this resource adopts them and destroying it only removes them from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{Description: "The name of the syslog settings.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"ca_certificate": schema.StringAttribute{
+				Description:   "The name of the CA certificate used to verify the syslog servers.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ca_certificate_group": schema.StringAttribute{
+				Description:   "The name of the certificate group whose CA certificates are used to verify the syslog servers.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"test_on_apply": schema.BoolAttribute{
+				Description: "If true, a test message is sent to every syslog server after the settings are applied, and the apply fails if any of them can't be reached.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Map FB API syslog settings to resource model
+func mapSyslogSettingsToModel(s *fb.SyslogServerSettings, model *syslogSettingsResourceModel) {
+	model.ID = types.StringPointerValue(s.Id)
+	model.Name = types.StringPointerValue(s.Name)
+	model.CaCertificate = types.StringNull()
+	if s.CaCertificate != nil {
+		model.CaCertificate = types.StringPointerValue(s.CaCertificate.Name)
+	}
+	model.CaCertificateGroup = types.StringNull()
+	if s.CaCertificateGroup != nil {
+		model.CaCertificateGroup = types.StringPointerValue(s.CaCertificateGroup.Name)
+	}
+}
+
+// update patches the syslog settings with whatever the plan sets. The settings are looked up
+// first, since the singleton is addressed by its name.
+func (r *syslogSettingsResource) update(ctx context.Context, plan *syslogSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	current, err := r.client.GetSyslogServerSettings(ctx)
+	if err != nil {
+		diags.AddError("Error Reading Syslog Settings", "Could not read the syslog server settings: "+err.Error())
+		return diags
+	}
+
+	var settingsToUpdate fb.SyslogServerSettings
+	if name := knownStringPointer(plan.CaCertificate); name != nil {
+		settingsToUpdate.CaCertificate = &struct {
+			Id           *string `json:"id,omitempty"`
+			Name         *string `json:"name,omitempty"`
+			ResourceType *string `json:"resource_type,omitempty"`
+		}{Name: name}
+	}
+	if name := knownStringPointer(plan.CaCertificateGroup); name != nil {
+		settingsToUpdate.CaCertificateGroup = &struct {
+			Id           *string `json:"id,omitempty"`
+			Name         *string `json:"name,omitempty"`
+			ResourceType *string `json:"resource_type,omitempty"`
+		}{Name: name}
+	}
+	if settingsToUpdate.CaCertificate == nil && settingsToUpdate.CaCertificateGroup == nil {
+		mapSyslogSettingsToModel(current, plan)
+		return diags
+	}
+
+	updated, err := r.client.UpdateSyslogServerSettings(ctx, types.StringPointerValue(current.Name).ValueString(), &settingsToUpdate)
+	if err != nil {
+		diags.AddError("Error Updating Syslog Settings", "Could not update the syslog server settings: "+err.Error())
+		return diags
+	}
+	mapSyslogSettingsToModel(updated, plan)
+	return diags
+}
+
+// test sends a test message to all syslog servers if test_on_apply is set, since the settings
+// apply to every one of them.
+func (r *syslogSettingsResource) test(ctx context.Context, model syslogSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !model.TestOnApply.ValueBool() {
+		return diags
+	}
+
+	results, err := r.client.TestSyslogServers(ctx)
+	if err != nil {
+		diags.AddError("Error Testing Syslog Servers", "Could not test the syslog servers: "+err.Error())
+		return diags
+	}
+	return testResultDiagnostics("Syslog Server Test Failed", results)
+}
+
+// --- CREATE ---
+func (r *syslogSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan syslogSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *syslogSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state syslogSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSyslogServerSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Syslog Settings", "Could not read the syslog server settings: "+err.Error())
+		return
+	}
+
+	mapSyslogSettingsToModel(settings, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *syslogSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan syslogSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(r.test(ctx, plan)...)
+}
+
+// --- DELETE ---
+// The syslog settings always exist, so they are left as they are and only dropped from the state.
+func (r *syslogSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Syslog server settings cannot be deleted, removing from state only.")
+}
+
+// --- CONFIGURE ---
+func (r *syslogSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// There is only one set of syslog settings, so any import ID will do.
+func (r *syslogSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &targetResource{}
+	_ resource.ResourceWithConfigure   = &targetResource{}
+	_ resource.ResourceWithImportState = &targetResource{}
+)
+
+func NewTargetResource() resource.Resource {
+	return &targetResource{}
+}
+
+type targetResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type targetResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Address       types.String `tfsdk:"address"`
+	Status        types.String `tfsdk:"status"`
+	StatusDetails types.String `tfsdk:"status_details"`
+}
+
+func (r *targetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target"
+}
+
+// --- SCHEMA ---
+func (r *targetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade replication target, an S3-compatible object store that buckets can be replicated to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the target.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"address":        schema.StringAttribute{Description: "The IP address or FQDN of the target.", Required: true},
+			"status":         schema.StringAttribute{Description: "The status of the connection to the target, e.g. `connected`.", Computed: true},
+			"status_details": schema.StringAttribute{Description: "Details about the status of the connection when it is unhealthy.", Computed: true},
+		},
+	}
+}
+
+// Map FB API target to resource model
+func mapTargetToModel(t *fb.Target, model *targetResourceModel) {
+	model.ID = types.StringPointerValue(t.Id)
+	model.Name = types.StringPointerValue(t.Name)
+	model.Address = types.StringPointerValue(t.Address)
+	model.Status = types.StringPointerValue(t.Status)
+	model.StatusDetails = types.StringPointerValue(t.StatusDetails)
+}
+
+// --- CREATE ---
+func (r *targetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan targetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdTarget, err := r.client.CreateTarget(ctx, plan.Name.ValueString(), &fb.TargetPost{Address: plan.Address.ValueStringPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Target", "Could not create target: "+err.Error())
+		return
+	}
+
+	mapTargetToModel(createdTarget, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *targetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state targetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.client.GetTargetByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Target", fmt.Sprintf("Could not read target %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if target == nil {
+		tflog.Warn(ctx, "Target not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapTargetToModel(target, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *targetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan targetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedTarget, err := r.client.UpdateTarget(ctx, plan.Name.ValueString(), &fb.Target{Address: plan.Address.ValueStringPointer()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Target", fmt.Sprintf("Could not update target: %s", err.Error()))
+		return
+	}
+
+	mapTargetToModel(updatedTarget, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *targetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state targetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTarget(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Target", fmt.Sprintf("Could not delete target %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *targetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *targetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &tlsPolicyResource{}
+	_ resource.ResourceWithConfigure   = &tlsPolicyResource{}
+	_ resource.ResourceWithImportState = &tlsPolicyResource{}
+)
+
+func NewTlsPolicyResource() resource.Resource {
+	return &tlsPolicyResource{}
+}
+
+type tlsPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type tlsPolicyResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	ApplianceCertificate types.String `tfsdk:"appliance_certificate"`
+	MinTlsVersion        types.String `tfsdk:"min_tls_version"`
+	EnabledTlsCiphers    types.Set    `tfsdk:"enabled_tls_ciphers"`
+	DisabledTlsCiphers   types.Set    `tfsdk:"disabled_tls_ciphers"`
+	IsLocal              types.Bool   `tfsdk:"is_local"`
+	PolicyType           types.String `tfsdk:"policy_type"`
+}
+
+func (r *tlsPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tls_policy"
+}
+
+// --- SCHEMA ---
+func (r *tlsPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade TLS policy, which sets the certificate and TLS versions and ciphers of the network interfaces it is applied to. " +
+			"Apply it with `flashblade_tls_policy_attachment`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the TLS policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, the policy is enforced.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"appliance_certificate": schema.StringAttribute{
+				Description:   "The name of the `flashblade_certificate` presented to clients.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"min_tls_version": schema.StringAttribute{
+				Description:   "The oldest TLS version clients may use, e.g. `TLSv1.2`, or `default` for the array's default.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enabled_tls_ciphers": schema.SetAttribute{
+				Description:   "The ciphers clients may use, or `default` for the array's defaults.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"disabled_tls_ciphers": schema.SetAttribute{
+				Description:   "Ciphers clients may not use, even if they are part of `enabled_tls_ciphers`.",
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+			"is_local":    schema.BoolAttribute{Description: "Whether the policy is defined on this array.", Computed: true, PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+// Map FB API TLS policy to resource model
+func mapTlsPolicyToModel(p *fb.TlsPolicy, model *tlsPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.ApplianceCertificate = types.StringNull()
+	if p.ApplianceCertificate != nil {
+		model.ApplianceCertificate = types.StringPointerValue(p.ApplianceCertificate.Name)
+	}
+	model.MinTlsVersion = types.StringPointerValue(p.MinTlsVersion)
+	model.EnabledTlsCiphers = stringSetValue(p.EnabledTlsCiphers)
+	model.DisabledTlsCiphers = stringSetValue(p.DisabledTlsCiphers)
+	model.IsLocal = types.BoolPointerValue(p.IsLocal)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+}
+
+func tlsPolicyFromPlan(ctx context.Context, plan tlsPolicyResourceModel) (fb.TlsPolicy, diag.Diagnostics) {
+	policy := fb.TlsPolicy{
+		Enabled:       knownBoolPointer(plan.Enabled),
+		MinTlsVersion: knownStringPointer(plan.MinTlsVersion),
+	}
+	if name := knownStringPointer(plan.ApplianceCertificate); name != nil {
+		policy.ApplianceCertificate = &fb.ReferenceWritable{Name: name}
+	}
+	var diags, d diag.Diagnostics
+	policy.EnabledTlsCiphers, d = stringsFromSet(ctx, plan.EnabledTlsCiphers)
+	diags.Append(d...)
+	policy.DisabledTlsCiphers, d = stringsFromSet(ctx, plan.DisabledTlsCiphers)
+	diags.Append(d...)
+	return policy, diags
+}
+
+// --- CREATE ---
+func (r *tlsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tlsPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := tlsPolicyFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	policyToCreate := fb.TlsPolicyPost{
+		Enabled:              policy.Enabled,
+		ApplianceCertificate: policy.ApplianceCertificate,
+		MinTlsVersion:        policy.MinTlsVersion,
+		EnabledTlsCiphers:    policy.EnabledTlsCiphers,
+		DisabledTlsCiphers:   policy.DisabledTlsCiphers,
+	}
+	createdPolicy, err := r.client.CreateTlsPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating TLS Policy", "Could not create TLS policy: "+err.Error())
+		return
+	}
+
+	mapTlsPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *tlsPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tlsPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetTlsPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading TLS Policy", fmt.Sprintf("Could not read TLS policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "TLS policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapTlsPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *tlsPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan tlsPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToUpdate, diags := tlsPolicyFromPlan(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatedPolicy, err := r.client.UpdateTlsPolicy(ctx, plan.Name.ValueString(), &policyToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating TLS Policy", fmt.Sprintf("Could not update TLS policy: %s", err.Error()))
+		return
+	}
+
+	mapTlsPolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *tlsPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tlsPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTlsPolicy(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting TLS Policy", fmt.Sprintf("Could not delete TLS policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *tlsPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *tlsPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
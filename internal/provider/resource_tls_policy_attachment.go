@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+func NewTlsPolicyAttachmentResource() resource.Resource {
+	return &policyAttachmentResource{policyAttachmentKind: policyAttachmentKind{
+		typeName:          "tls_policy_attachment",
+		description:       "Applies a Pure Storage FlashBlade TLS policy to a network interface.",
+		policyTitle:       "TLS Policy",
+		policyLabel:       "TLS policy",
+		policyDescription: "The name of the TLS policy.",
+		memberAttribute:   "network_interface",
+		memberLabel:       "network interface",
+		memberDescription: "The name of the network interface.",
+		add: func(ctx context.Context, c *client.Client, policy, member string) error {
+			return c.AddTlsPolicyNetworkInterface(ctx, policy, member)
+		},
+		exists: func(ctx context.Context, c *client.Client, policy, member string) (bool, error) {
+			membership, err := c.GetTlsPolicyNetworkInterface(ctx, policy, member)
+			return membership != nil, err
+		},
+		remove: func(ctx context.Context, c *client.Client, policy, member string) error {
+			return c.RemoveTlsPolicyNetworkInterface(ctx, policy, member)
+		},
+	}}
+}
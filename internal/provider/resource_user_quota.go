@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &userQuotaResource{}
+	_ resource.ResourceWithConfigure      = &userQuotaResource{}
+	_ resource.ResourceWithImportState    = &userQuotaResource{}
+	_ resource.ResourceWithValidateConfig = &userQuotaResource{}
+)
+
+func NewUserQuotaResource() resource.Resource {
+	return &userQuotaResource{}
+}
+
+type userQuotaResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type userQuotaResourceModel struct {
+	quotaResourceModel
+	UID      types.Int64  `tfsdk:"uid"`
+	UserName types.String `tfsdk:"user_name"`
+}
+
+func (r *userQuotaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_quota"
+}
+
+// --- SCHEMA ---
+func (r *userQuotaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the quota of a single user on a Pure Storage FlashBlade file system, overriding the file system's `default_user_quota`.",
+		Attributes: map[string]schema.Attribute{
+			"file_system": schema.StringAttribute{
+				Description:   "The name of the file system the quota applies to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"uid": schema.Int64Attribute{
+				Description:   "The user ID the quota applies to. Exactly one of `uid` and `user_name` must be set.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplaceIfConfigured()},
+			},
+			"user_name": schema.StringAttribute{
+				Description:   "The name of the user the quota applies to. Exactly one of `uid` and `user_name` must be set.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplaceIfConfigured()},
+			},
+			"quota": schema.Int64Attribute{
+				Description: "The space the user may use on the file system, in bytes.",
+				Required:    true,
+			},
+			"usage":                     schema.Int64Attribute{Description: "The space currently used by the user on the file system, in bytes.", Computed: true},
+			"file_system_default_quota": schema.Int64Attribute{Description: "The default user quota of the file system, which this quota overrides.", Computed: true},
+		},
+	}
+}
+
+func (r *userQuotaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config userQuotaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.UID.IsUnknown() || config.UserName.IsUnknown() {
+		return
+	}
+	if config.UID.IsNull() == config.UserName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("uid"), "Invalid User Quota Configuration", "Exactly one of `uid` and `user_name` must be set.")
+	}
+}
+
+// Map FB API user quota to resource model
+func mapUserQuotaToModel(q *fb.UserQuota, model *userQuotaResourceModel) {
+	mapQuotaToModel(q.FileSystem, q.Quota, q.Usage, q.FileSystemDefaultQuota, &model.quotaResourceModel)
+	if q.User != nil {
+		model.UID = types.Int64PointerValue(q.User.Id)
+		model.UserName = types.StringPointerValue(q.User.Name)
+	}
+}
+
+// --- CREATE ---
+func (r *userQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userQuotaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uid, userName, err := quotaKey(plan.UID, plan.UserName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating User Quota", "Could not create user quota: "+err.Error())
+		return
+	}
+	createdQuota, err := r.client.CreateUserQuota(ctx, plan.FileSystem.ValueString(), uid, userName, plan.Quota.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating User Quota", "Could not create user quota: "+err.Error())
+		return
+	}
+
+	mapUserQuotaToModel(createdQuota, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *userQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userQuotaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uid, userName, err := quotaKey(state.UID, state.UserName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading User Quota", fmt.Sprintf("Could not read user quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	quota, err := r.client.GetUserQuota(ctx, state.FileSystem.ValueString(), uid, userName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading User Quota", fmt.Sprintf("Could not read user quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	if quota == nil {
+		tflog.Warn(ctx, "User quota not found, removing from state.", map[string]interface{}{"file_system": state.FileSystem.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapUserQuotaToModel(quota, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *userQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uid, userName, err := quotaKey(state.UID, state.UserName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating User Quota", fmt.Sprintf("Could not update user quota: %s", err.Error()))
+		return
+	}
+	updatedQuota, err := r.client.UpdateUserQuota(ctx, state.FileSystem.ValueString(), uid, userName, plan.Quota.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating User Quota", fmt.Sprintf("Could not update user quota: %s", err.Error()))
+		return
+	}
+
+	mapUserQuotaToModel(updatedQuota, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *userQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userQuotaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uid, userName, err := quotaKey(state.UID, state.UserName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting User Quota", fmt.Sprintf("Could not delete user quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	err = r.client.DeleteUserQuota(ctx, state.FileSystem.ValueString(), uid, userName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting User Quota", fmt.Sprintf("Could not delete user quota on file system %s: %s", state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *userQuotaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// User quotas are imported with an ID of the form "<file_system>/<uid>" or "<file_system>/<user_name>".
+func (r *userQuotaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fileSystem, user, ok := splitLastSlash(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <file_system>/<uid> or <file_system>/<user_name>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_system"), fileSystem)...)
+	if uid, err := strconv.ParseInt(user, 10, 32); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid"), uid)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_name"), user)...)
+	}
+}
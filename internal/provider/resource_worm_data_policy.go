@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fb "terraform-provider-flashblade/fb_sdk"
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &wormDataPolicyResource{}
+	_ resource.ResourceWithConfigure      = &wormDataPolicyResource{}
+	_ resource.ResourceWithImportState    = &wormDataPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &wormDataPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &wormDataPolicyResource{}
+)
+
+func NewWormDataPolicyResource() resource.Resource {
+	return &wormDataPolicyResource{}
+}
+
+type wormDataPolicyResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type wormDataPolicyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	MinRetention     types.Int64  `tfsdk:"min_retention"`
+	MaxRetention     types.Int64  `tfsdk:"max_retention"`
+	DefaultRetention types.Int64  `tfsdk:"default_retention"`
+	Mode             types.String `tfsdk:"mode"`
+	RetentionLock    types.String `tfsdk:"retention_lock"`
+	PolicyType       types.String `tfsdk:"policy_type"`
+}
+
+func (r *wormDataPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_data_policy"
+}
+
+// --- SCHEMA ---
+func (r *wormDataPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Pure Storage FlashBlade WORM data policy, which makes committed files immutable for a retention period. " +
+			"Apply it with `flashblade_worm_data_policy_attachment`. Once `retention_lock` is `locked`, the retention settings can no longer be changed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Description: "A non-modifiable, globally unique ID chosen by the system.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+			"name": schema.StringAttribute{
+				Description:   "The name of the WORM data policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:   "If true, the policy is enforced.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"min_retention": schema.Int64Attribute{
+				Description:   "The minimum retention period of committed files, in milliseconds.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"max_retention": schema.Int64Attribute{
+				Description:   "The maximum retention period of committed files, in milliseconds.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"default_retention": schema.Int64Attribute{
+				Description:   "The retention period, in milliseconds, applied to files committed without an explicit access time.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"mode": schema.StringAttribute{
+				Description:   "The type of the retention lock, e.g. `compliance`.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"retention_lock": schema.StringAttribute{
+				Description: "Either `unlocked` or `locked`. Locking is irreversible: the retention settings can no longer be changed, " +
+					"and only Pure Technical Services can unlock the policy again.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"policy_type": schema.StringAttribute{Description: "The type of the policy.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		},
+	}
+}
+
+func (r *wormDataPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config wormDataPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if lock := config.RetentionLock.ValueString(); !config.RetentionLock.IsUnknown() && lock != "" && lock != "locked" && lock != "unlocked" {
+		resp.Diagnostics.AddAttributeError(path.Root("retention_lock"), "Invalid Retention Lock",
+			fmt.Sprintf("`retention_lock` must be `locked` or `unlocked`, got %q.", lock))
+	}
+	minimum, maximum, def := knownInt64Pointer(config.MinRetention), knownInt64Pointer(config.MaxRetention), knownInt64Pointer(config.DefaultRetention)
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		resp.Diagnostics.AddAttributeError(path.Root("min_retention"), "Invalid Retention Range", "`min_retention` must not be greater than `max_retention`.")
+	}
+	if def != nil && ((minimum != nil && *def < *minimum) || (maximum != nil && *def > *maximum)) {
+		resp.Diagnostics.AddAttributeError(path.Root("default_retention"), "Invalid Retention Range", "`default_retention` must be between `min_retention` and `max_retention`.")
+	}
+}
+
+// ModifyPlan refuses changes that a locked retention doesn't allow, and warns before a change
+// that can't be undone. It checks the policy on the array rather than the state, since the policy
+// may have been locked outside of Terraform.
+func (r *wormDataPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		if req.Plan.Raw.IsNull() {
+			return
+		}
+		var plan wormDataPolicyResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if !resp.Diagnostics.HasError() && plan.RetentionLock.ValueString() == "locked" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("retention_lock"), "WORM Data Policy Will Be Locked", wormLockWarning(plan.Name.ValueString()))
+		}
+		return
+	}
+	if r.client == nil {
+		return
+	}
+
+	var state wormDataPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current, err := r.client.GetWormDataPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading WORM Data Policy", fmt.Sprintf("Could not read WORM data policy %s to check its retention lock: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if current == nil {
+		return
+	}
+	locked := types.StringPointerValue(current.RetentionLock).ValueString() == "locked"
+
+	if req.Plan.Raw.IsNull() {
+		if locked {
+			resp.Diagnostics.AddWarning("Deleting Retention-Locked WORM Data Policy",
+				fmt.Sprintf("WORM data policy %s is locked. The array refuses to delete it while it is applied to a file system, and files already committed stay immutable until their retention expires.", state.Name.ValueString()))
+		}
+		return
+	}
+
+	var plan wormDataPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !locked {
+		if plan.RetentionLock.ValueString() == "locked" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("retention_lock"), "WORM Data Policy Will Be Locked", wormLockWarning(plan.Name.ValueString()))
+		}
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "WORM Data Policy Is Locked",
+			fmt.Sprintf("WORM data policy %s is locked and cannot be replaced.", state.Name.ValueString()))
+	}
+	if !plan.RetentionLock.IsUnknown() && plan.RetentionLock.ValueString() != "locked" {
+		resp.Diagnostics.AddAttributeError(path.Root("retention_lock"), "WORM Data Policy Is Locked",
+			fmt.Sprintf("WORM data policy %s is locked. Only Pure Technical Services can unlock it.", state.Name.ValueString()))
+	}
+	lockedAttributes := []struct {
+		name             string
+		planned, current attr.Value
+	}{
+		{"enabled", plan.Enabled, types.BoolPointerValue(current.Enabled)},
+		{"min_retention", plan.MinRetention, types.Int64PointerValue(current.MinRetention)},
+		{"max_retention", plan.MaxRetention, types.Int64PointerValue(current.MaxRetention)},
+		{"default_retention", plan.DefaultRetention, types.Int64PointerValue(current.DefaultRetention)},
+		{"mode", plan.Mode, types.StringPointerValue(current.Mode)},
+	}
+	for _, a := range lockedAttributes {
+		if !a.planned.IsUnknown() && !a.planned.IsNull() && !a.planned.Equal(a.current) {
+			resp.Diagnostics.AddAttributeError(path.Root(a.name), "WORM Data Policy Is Locked",
+				fmt.Sprintf("`%s` of WORM data policy %s cannot be changed from %s to %s, because its retention is locked.", a.name, state.Name.ValueString(), a.current, a.planned))
+		}
+	}
+}
+
+// wormLockWarning explains the consequences of locking the retention of a WORM data policy, because
+// the lock is permanent.
+func wormLockWarning(name string) string {
+	return fmt.Sprintf("Locking WORM data policy %s cannot be undone. Afterwards its retention settings can no longer be changed, and only Pure Technical Services can unlock it.", name)
+}
+
+// Map FB API WORM data policy to resource model
+func mapWormDataPolicyToModel(p *fb.WormDataPolicy, model *wormDataPolicyResourceModel) {
+	model.ID = types.StringPointerValue(p.Id)
+	model.Name = types.StringPointerValue(p.Name)
+	model.Enabled = types.BoolPointerValue(p.Enabled)
+	model.MinRetention = types.Int64PointerValue(p.MinRetention)
+	model.MaxRetention = types.Int64PointerValue(p.MaxRetention)
+	model.DefaultRetention = types.Int64PointerValue(p.DefaultRetention)
+	model.Mode = types.StringPointerValue(p.Mode)
+	model.RetentionLock = types.StringPointerValue(p.RetentionLock)
+	model.PolicyType = types.StringPointerValue(p.PolicyType)
+}
+
+// --- CREATE ---
+func (r *wormDataPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan wormDataPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToCreate := fb.WormDataPolicy{
+		Enabled:          knownBoolPointer(plan.Enabled),
+		MinRetention:     knownInt64Pointer(plan.MinRetention),
+		MaxRetention:     knownInt64Pointer(plan.MaxRetention),
+		DefaultRetention: knownInt64Pointer(plan.DefaultRetention),
+		Mode:             knownStringPointer(plan.Mode),
+		RetentionLock:    knownStringPointer(plan.RetentionLock),
+	}
+	createdPolicy, err := r.client.CreateWormDataPolicy(ctx, plan.Name.ValueString(), &policyToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating WORM Data Policy", "Could not create WORM data policy: "+err.Error())
+		return
+	}
+
+	mapWormDataPolicyToModel(createdPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *wormDataPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state wormDataPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetWormDataPolicyByName(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading WORM Data Policy", fmt.Sprintf("Could not read WORM data policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+	if policy == nil {
+		tflog.Warn(ctx, "WORM data policy not found, removing from state.", map[string]interface{}{"name": state.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapWormDataPolicyToModel(policy, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// --- UPDATE ---
+func (r *wormDataPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan wormDataPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyToUpdate := fb.WormDataPolicy{
+		Enabled:          knownBoolPointer(plan.Enabled),
+		MinRetention:     knownInt64Pointer(plan.MinRetention),
+		MaxRetention:     knownInt64Pointer(plan.MaxRetention),
+		DefaultRetention: knownInt64Pointer(plan.DefaultRetention),
+		Mode:             knownStringPointer(plan.Mode),
+		RetentionLock:    knownStringPointer(plan.RetentionLock),
+	}
+	updatedPolicy, err := r.client.UpdateWormDataPolicy(ctx, plan.Name.ValueString(), &policyToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating WORM Data Policy", fmt.Sprintf("Could not update WORM data policy: %s", err.Error()))
+		return
+	}
+
+	mapWormDataPolicyToModel(updatedPolicy, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- DELETE ---
+func (r *wormDataPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state wormDataPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteWormDataPolicy(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting WORM Data Policy", fmt.Sprintf("Could not delete WORM data policy %s: %s", state.Name.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *wormDataPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+func (r *wormDataPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-flashblade/internal/client"
+)
+
+var (
+	_ resource.Resource                = &wormDataPolicyAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &wormDataPolicyAttachmentResource{}
+	_ resource.ResourceWithImportState = &wormDataPolicyAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &wormDataPolicyAttachmentResource{}
+)
+
+func NewWormDataPolicyAttachmentResource() resource.Resource {
+	return &wormDataPolicyAttachmentResource{}
+}
+
+type wormDataPolicyAttachmentResource struct {
+	client *client.Client
+}
+
+// --- MODELS ---
+type wormDataPolicyAttachmentResourceModel struct {
+	Policy     types.String `tfsdk:"policy"`
+	FileSystem types.String `tfsdk:"file_system"`
+}
+
+func (r *wormDataPolicyAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_data_policy_attachment"
+}
+
+// --- SCHEMA ---
+func (r *wormDataPolicyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies a Pure Storage FlashBlade WORM data policy to a file system. Once the policy is locked, it can no longer be removed.",
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{
+				Description:   "The name of the WORM data policy.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"file_system": schema.StringAttribute{
+				Description:   "The name of the file system.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+// ModifyPlan refuses to remove a locked policy from a file system, since the retention of the files
+// committed under it can't be lifted. Like the policy resource, it checks the lock on the array.
+func (r *wormDataPolicyAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var state wormDataPolicyAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.Plan.Raw.IsNull() {
+		var plan wormDataPolicyAttachmentResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() || (plan.Policy.Equal(state.Policy) && plan.FileSystem.Equal(state.FileSystem)) {
+			return
+		}
+	}
+
+	policy, err := r.client.GetWormDataPolicyByName(ctx, state.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading WORM Data Policy", fmt.Sprintf("Could not read WORM data policy %s to check its retention lock: %s", state.Policy.ValueString(), err.Error()))
+		return
+	}
+	if policy != nil && types.StringPointerValue(policy.RetentionLock).ValueString() == "locked" {
+		resp.Diagnostics.AddError("WORM Data Policy Is Locked",
+			fmt.Sprintf("WORM data policy %s is locked, so it cannot be removed from file system %s. Remove this resource from the state with `terraform state rm` if it should no longer be managed.", state.Policy.ValueString(), state.FileSystem.ValueString()))
+	}
+}
+
+// --- CREATE ---
+func (r *wormDataPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan wormDataPolicyAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AddFileSystemPolicy(ctx, plan.Policy.ValueString(), plan.FileSystem.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Attaching WORM Data Policy", fmt.Sprintf("Could not attach WORM data policy %s to file system %s: %s", plan.Policy.ValueString(), plan.FileSystem.ValueString(), err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// --- READ ---
+func (r *wormDataPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state wormDataPolicyAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	membership, err := r.client.GetFileSystemWormDataPolicy(ctx, state.Policy.ValueString(), state.FileSystem.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading WORM Data Policy Attachment", fmt.Sprintf("Could not read attachment of WORM data policy %s to file system %s: %s", state.Policy.ValueString(), state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+	if membership == nil {
+		tflog.Warn(ctx, "WORM data policy attachment not found, removing from state.", map[string]interface{}{"policy": state.Policy.ValueString(), "file_system": state.FileSystem.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+// --- UPDATE ---
+// Both attributes force replacement, so there is nothing to update.
+func (r *wormDataPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+// --- DELETE ---
+func (r *wormDataPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state wormDataPolicyAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveFileSystemPolicy(ctx, state.Policy.ValueString(), state.FileSystem.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Detaching WORM Data Policy", fmt.Sprintf("Could not detach WORM data policy %s from file system %s: %s", state.Policy.ValueString(), state.FileSystem.ValueString(), err.Error()))
+		return
+	}
+}
+
+// --- CONFIGURE ---
+func (r *wormDataPolicyAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// --- IMPORT ---
+// Attachments are imported with an ID of the form "<policy>,<file_system>".
+func (r *wormDataPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected an import ID of the form <policy>,<file_system>, got: %q.", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_system"), parts[1])...)
+}